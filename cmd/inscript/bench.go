@@ -0,0 +1,63 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/SethGK/Inscript/internal/compiler"
+	"github.com/SethGK/Inscript/internal/loader"
+	vmpkg "github.com/SethGK/Inscript/internal/vm"
+)
+
+// bench runs each script several times with and without the peephole
+// optimizer and reports the best time of each, so the effect of the
+// superinstructions can be measured. Output from the scripts is discarded.
+func bench(args []string) {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	fs.Usage = flag.Usage
+	runs := fs.Int("n", 5, "number of `runs` of each script")
+	fs.Parse(args)
+	if fs.NArg() == 0 || *runs < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "script\tplain\tpeephole\tspeedup\t")
+	for _, filePath := range fs.Args() {
+		program := parseFile(filePath)
+		plain := bestTime(filePath, compileProgram(program, false), *runs)
+		fused := bestTime(filePath, compileProgram(program, true), *runs)
+		fmt.Fprintf(w, "%s\t%v\t%v\t%.2fx\t\n", filePath, plain.Round(time.Microsecond), fused.Round(time.Microsecond), float64(plain)/float64(fused))
+	}
+	w.Flush()
+}
+
+// bestTime returns the shortest of several runs of bytecode, exiting with the
+// error if a run fails.
+func bestTime(filePath string, bytecode *compiler.Bytecode, runs int) time.Duration {
+	var best time.Duration
+	for i := 0; i < runs; i++ {
+		importer := loader.New(filePath, loader.SearchPathsFromEnv()...)
+		importer.Output = io.Discard
+		vm := vmpkg.New(bytecode)
+		vm.SetOutput(io.Discard)
+		vm.SetImporter(importer)
+
+		start := time.Now()
+		err := vm.Run()
+		elapsed := time.Since(start)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", filePath, err)
+			os.Exit(1)
+		}
+		if i == 0 || elapsed < best {
+			best = elapsed
+		}
+	}
+	return best
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/SethGK/Inscript/internal/ast"
+	"github.com/SethGK/Inscript/internal/compiler"
+	vmpkg "github.com/SethGK/Inscript/internal/vm"
+)
+
+// BenchmarkScripts runs each script in benchmarks/ with and without the
+// peephole optimizer, the same comparison the bench command makes:
+//
+//	go test -bench . ./cmd/inscript
+func BenchmarkScripts(b *testing.B) {
+	paths, err := filepath.Glob(filepath.Join("..", "..", "benchmarks", "*.ins"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	if len(paths) == 0 {
+		b.Fatal("no scripts in benchmarks/")
+	}
+	for _, path := range paths {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			b.Fatal(err)
+		}
+		program, err := ast.ParseFile(path, string(src))
+		if err != nil {
+			b.Fatal(err)
+		}
+		name := strings.TrimSuffix(filepath.Base(path), ".ins")
+		for _, peephole := range []bool{false, true} {
+			comp := compiler.New()
+			comp.Peephole = peephole
+			bytecode, err := comp.Compile(program)
+			if err != nil {
+				b.Fatalf("%s: %v", path, err)
+			}
+			mode := "plain"
+			if peephole {
+				mode = "peephole"
+			}
+			b.Run(name+"/"+mode, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					vm := vmpkg.New(bytecode)
+					vm.SetOutput(io.Discard)
+					if err := vm.Run(); err != nil {
+						b.Fatalf("%s: %v", path, err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -1,93 +1,207 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
 	"os"
+	"strings"
 
-	"github.com/SethGK/Inscript/internal/ast"          // Import AST package
-	"github.com/SethGK/Inscript/internal/compiler"     // Import Compiler package
-	"github.com/SethGK/Inscript/internal/types"        // Import types package
-	"github.com/SethGK/Inscript/internal/vm"           // Import VM package
-	parser "github.com/SethGK/Inscript/parser/grammar" // Import the ANTLR generated parser package
-	"github.com/antlr4-go/antlr/v4"                    // Import ANTLR runtime
+	"github.com/SethGK/Inscript/internal/ast"       // Import AST package
+	"github.com/SethGK/Inscript/internal/compiler"  // Import Compiler package
+	"github.com/SethGK/Inscript/internal/loader"    // Import module loader package
+	"github.com/SethGK/Inscript/internal/typecheck" // Import static type checker package
+	vmpkg "github.com/SethGK/Inscript/internal/vm"  // Import VM package
 )
 
+var (
+	typecheckFlag = flag.Bool("typecheck", false, "check type annotations before compiling")
+	disasmFlag    = flag.Bool("disasm", false, "list the bytecode before running it")
+	traceFlag     = flag.Bool("trace", false, "print each instruction, call, return and error to stderr while running")
+)
+
+const usage = `usage:
+  %[1]s [flags] [repl]                    start an interactive session
+  %[1]s [flags] file.ins                  compile and run a script
+  %[1]s [flags] run file.ins|file.insc    run a script or compiled bytecode
+  %[1]s [flags] build file.ins [-o out]   compile a script to bytecode (default out: file.insc)
+  %[1]s [flags] disasm file.ins|file.insc list the bytecode of a script
+  %[1]s [flags] bench [-n runs] file.ins...
+                                          time scripts with and without the peephole optimizer
+
+flags:
+`
+
 func main() {
-	// 1. Read Source Code (Example: from a file specified as a command-line argument)
-	if len(os.Args) < 2 {
-		fmt.Println("Usage: inscript <source_file.ins>")
-		os.Exit(1)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), usage, os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	// With no file (or the "repl" command), start an interactive session.
+	if flag.NArg() < 1 || flag.Arg(0) == "repl" {
+		NewREPL(os.Stdout).Run(os.Stdin)
+		return
+	}
+
+	switch flag.Arg(0) {
+	case "build":
+		build(flag.Args()[1:])
+	case "bench":
+		bench(flag.Args()[1:])
+	case "disasm":
+		if flag.NArg() != 2 {
+			flag.Usage()
+			os.Exit(2)
+		}
+		if err := compiler.Disassemble(os.Stdout, loadFile(flag.Arg(1))); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing listing: %v\n", err)
+			os.Exit(1)
+		}
+	case "run":
+		if flag.NArg() != 2 {
+			flag.Usage()
+			os.Exit(2)
+		}
+		run(flag.Arg(1))
+	default:
+		run(flag.Arg(0))
 	}
-	filePath := os.Args[1]
-	input, err := antlr.NewFileStream(filePath)
+}
+
+// build compiles a script and writes its bytecode, so it can be run later
+// without parsing it again.
+func build(args []string) {
+	fs := flag.NewFlagSet("build", flag.ExitOnError)
+	fs.Usage = flag.Usage
+	output := fs.String("o", "", "output `file` (default: the input with a "+loader.BytecodeExt+" extension)")
+
+	// Accept -o on either side of the input file.
+	var inputs []string
+	for {
+		fs.Parse(args)
+		if fs.NArg() == 0 {
+			break
+		}
+		inputs = append(inputs, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+	if len(inputs) != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	filePath := inputs[0]
+	if *output == "" {
+		*output = strings.TrimSuffix(filePath, loader.SourceExt) + loader.BytecodeExt
+	}
+
+	bytecode := compileFile(filePath)
+	data, err := bytecode.MarshalBinary()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error reading file %s: %v\n", filePath, err)
+		fmt.Fprintf(os.Stderr, "Error encoding bytecode: %v\n", err)
 		os.Exit(1)
 	}
+	if err := os.WriteFile(*output, data, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing file %s: %v\n", *output, err)
+		os.Exit(1)
+	}
+}
 
-	// 2. Lex and Parse
-	lexer := parser.NewInscriptLexer(input)
-	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
-	p := parser.NewInscriptParser(stream)
-
-	// Enable tracing and diagnostics (optional, for clearer output during debugging)
-	// p.AddErrorListener(antlr.NewDiagnosticErrorListener(true))
-	// lexer.AddErrorListener(antlr.NewDiagnosticErrorListener(true))
+// compileFile parses and compiles a script, exiting with the error if it fails.
+func compileFile(filePath string) *compiler.Bytecode {
+	return compileProgram(parseFile(filePath), true)
+}
 
-	// Use the default error strategy
-	p.SetErrorHandler(antlr.NewDefaultErrorStrategy())
+// parseFile reads and parses a script, checking its types when asked to,
+// exiting with the error if it fails.
+func parseFile(filePath string) *ast.Program {
+	// 1. Read Source Code (Example: from a file specified as a command-line argument)
+	src, err := os.ReadFile(filePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading file %s: %v\n", filePath, err)
+		os.Exit(1)
+	}
 
-	// Parse the top-level rule
-	parseTree := p.Program()
+	// 2. Lex and Parse, 3. Build AST
+	astProgram, err := ast.ParseFile(filePath, string(src))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err) // Syntax errors carry their position and source line
+		os.Exit(1)
+	}
 
-	// 3. Build AST
-	builder := ast.NewASTBuilder()
-	astProgram := parseTree.Accept(builder).(*ast.Program)
+	// Type annotations are only enforced when asked for, so untyped scripts still run.
+	if *typecheckFlag {
+		if err := typecheck.Check(astProgram); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+	return astProgram
+}
 
+// compileProgram compiles a parsed script, with or without the peephole
+// optimizer, exiting with the error if it fails.
+func compileProgram(astProgram *ast.Program, peephole bool) *compiler.Bytecode {
 	// 4. Compile
 	comp := compiler.New()
+	comp.Peephole = peephole
 	bytecode, err := comp.Compile(astProgram)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Compilation error: %v\n", err)
 		os.Exit(1)
 	}
+	return bytecode
+}
 
-	// --- Bytecode Inspection ---
-	fmt.Println("--- Main Program Bytecode ---")
-	// Use the String() method on compiler.Instructions
-	fmt.Println(bytecode.Instructions.String())
-	fmt.Println("---------------------------")
-
-	fmt.Println("--- Constants ---")
-	for i, constant := range bytecode.Constants {
-		fmt.Printf("%d: %s\n", i, constant.Inspect())
-
-		// If the constant is a CompiledFunction (raw function bytecode)
-		if compiledFn, ok := constant.(*types.CompiledFunction); ok {
-			fmt.Printf("  --- Bytecode for Compiled Function (Constant %d) ---\n", i)
-			// Call String() method on the function's instructions
-			fmt.Println(compiler.Instructions(compiledFn.Instructions).String())
-			fmt.Println("  ------------------------------------------")
-		} else if closure, ok := constant.(*types.Closure); ok {
-			// If it's a Closure, inspect its underlying CompiledFunction's bytecode
-			fmt.Printf("  --- Bytecode for Closure's Function (Constant %d) ---\n", i)
-			fmt.Println(compiler.Instructions(closure.Fn.Instructions).String())
-			fmt.Printf("  Free Variables Count: %d\n", len(closure.Free))
-			for j, freeVar := range closure.Free {
-				fmt.Printf("    Free[%d]: %s\n", j, freeVar.Inspect())
-			}
-			fmt.Println("  ------------------------------------------")
+// loadFile returns the bytecode of a script, decoding it directly if the file
+// holds compiled bytecode.
+func loadFile(filePath string) *compiler.Bytecode {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading file %s: %v\n", filePath, err)
+		os.Exit(1)
+	}
+	if !compiler.IsBytecode(data) {
+		return compileFile(filePath)
+	}
+	bytecode := new(compiler.Bytecode)
+	if err := bytecode.UnmarshalBinary(data); err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading %s: %v\n", filePath, err)
+		os.Exit(1)
+	}
+	return bytecode
+}
+
+// run executes a script or a compiled bytecode file.
+func run(filePath string) {
+	bytecode := loadFile(filePath)
+
+	if *disasmFlag {
+		if err := compiler.Disassemble(os.Stdout, bytecode); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing listing: %v\n", err)
+			os.Exit(1)
 		}
 	}
-	fmt.Println("-----------------")
-	// --- End of bytecode inspection ---
 
 	// 5. Execute
-	vm := vm.New(bytecode)
+	vm := vmpkg.New(bytecode)
+	vm.SetImporter(loader.New(filePath, loader.SearchPathsFromEnv()...))
+	if *traceFlag {
+		vm.SetTracer(NewVMTracer(os.Stderr))
+	}
 
-	err = vm.Run()
+	err := vm.Run()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Runtime error: %v\n", err)
+		var runtimeErr *vmpkg.RuntimeError
+		var verifyErr *compiler.VerifyError
+		if errors.As(err, &runtimeErr) {
+			fmt.Fprintln(os.Stderr, runtimeErr.FormatTraceback())
+		} else if errors.As(err, &verifyErr) {
+			fmt.Fprintln(os.Stderr, verifyErr) // Already names the file and offset
+		} else {
+			fmt.Fprintf(os.Stderr, "Runtime error: %v\n", err)
+		}
 		os.Exit(1)
 	}
 
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/SethGK/Inscript/internal/ast"
+	"github.com/SethGK/Inscript/internal/compiler"
+	"github.com/SethGK/Inscript/internal/loader"
+	"github.com/SethGK/Inscript/internal/types"
+	vmpkg "github.com/SethGK/Inscript/internal/vm"
+	parser "github.com/SethGK/Inscript/parser/grammar"
+)
+
+const (
+	replPrompt       = ">>> "
+	replContinuation = "... "
+	replHistoryFile  = ".inscript_history" // Stored in the user's home directory
+	replResultName   = "_"                 // Global holding the value of the last expression
+)
+
+// REPL is an interactive session. Globals, the symbol table and the constant
+// pool persist from one input to the next.
+type REPL struct {
+	symbols   *compiler.SymbolTable
+	constants []types.Value
+	module    *types.Module // Shared by every input, so closures see later assignments
+	importer  *loader.Loader
+
+	history     []string
+	historyPath string // Empty when history is not persisted
+
+	out io.Writer
+}
+
+// NewREPL creates a session that writes results and errors to out.
+func NewREPL(out io.Writer) *REPL {
+	r := &REPL{
+		symbols:   compiler.NewGlobalSymbolTable(),
+		constants: make([]types.Value, 0),
+		module:    &types.Module{Name: "main"},
+		importer:  loader.New("", loader.SearchPathsFromEnv()...),
+		out:       out,
+	}
+	r.importer.Output = out
+	if home, err := os.UserHomeDir(); err == nil {
+		r.historyPath = filepath.Join(home, replHistoryFile)
+		r.loadHistory()
+	}
+	return r
+}
+
+// Run reads inputs from in until EOF or :quit. An input continues over several
+// lines while it has unclosed braces, brackets, parentheses, strings or comments.
+//
+// History commands: :history lists previous inputs, !! repeats the last one and
+// !N repeats input N.
+func (r *REPL) Run(in io.Reader) {
+	scanner := bufio.NewScanner(in)
+	var pending strings.Builder
+
+	fmt.Fprint(r.out, replPrompt)
+	for scanner.Scan() {
+		pending.WriteString(scanner.Text())
+		pending.WriteString("\n")
+		src := pending.String()
+		if incomplete(src) {
+			fmt.Fprint(r.out, replContinuation)
+			continue
+		}
+		pending.Reset()
+
+		src = strings.TrimSpace(src)
+		switch {
+		case src == "":
+		case src == ":quit" || src == ":exit":
+			return
+		case src == ":history":
+			for i, entry := range r.history {
+				fmt.Fprintf(r.out, "%5d  %s\n", i+1, strings.ReplaceAll(entry, "\n", "\n       "))
+			}
+		case strings.HasPrefix(src, "!"):
+			entry, err := r.recall(src[1:])
+			if err != nil {
+				fmt.Fprintln(r.out, err)
+				break
+			}
+			fmt.Fprintln(r.out, entry)
+			r.addHistory(entry)
+			r.Eval(entry)
+		default:
+			r.addHistory(src)
+			r.Eval(src)
+		}
+		fmt.Fprint(r.out, replPrompt)
+	}
+	fmt.Fprintln(r.out)
+}
+
+// Eval compiles and runs one input. If the input ends with an expression
+// statement, its value is stored in the global _ and printed unless it is nil.
+func (r *REPL) Eval(src string) {
+	program, err := ast.ParseFile("<stdin>", src)
+	if err != nil {
+		fmt.Fprintln(r.out, err)
+		return
+	}
+
+	showResult := false
+	if n := len(program.Stmts); n > 0 {
+		if stmt, ok := program.Stmts[n-1].(*ast.ExprStmt); ok {
+			program.Stmts[n-1] = &ast.AssignStmt{
+				Target:   &ast.Identifier{Name: replResultName, PosToken: stmt.PosToken},
+				Op:       ast.Token{Type: parser.InscriptParserASSIGN, Pos: stmt.PosToken, Literal: "="},
+				Value:    stmt.Expr,
+				PosToken: stmt.PosToken,
+			}
+			showResult = true
+		}
+	}
+
+	bytecode, err := compiler.NewWithState(r.symbols, r.constants).Compile(program)
+	if err != nil {
+		fmt.Fprintf(r.out, "Compilation error: %v\n", err)
+		return
+	}
+	r.constants = bytecode.Constants
+
+	machine := vmpkg.NewWithModule(bytecode, r.module)
+	machine.SetOutput(r.out)
+	machine.SetImporter(r.importer)
+	err = machine.Run()
+	if err != nil {
+		var runtimeErr *vmpkg.RuntimeError
+		if errors.As(err, &runtimeErr) {
+			fmt.Fprintln(r.out, runtimeErr.FormatTraceback())
+		} else {
+			fmt.Fprintf(r.out, "Runtime error: %v\n", err)
+		}
+		return
+	}
+
+	if showResult {
+		sym, _ := r.symbols.Resolve(replResultName)
+		if result := r.module.Globals[sym.Index]; result != nil {
+			if _, isNil := result.(*types.Nil); !isNil {
+				fmt.Fprintln(r.out, result.Inspect())
+			}
+		}
+	}
+}
+
+// recall resolves a history reference: "!" for the last entry or a 1-based index.
+func (r *REPL) recall(ref string) (string, error) {
+	if len(r.history) == 0 {
+		return "", fmt.Errorf("history is empty")
+	}
+	if ref == "!" {
+		return r.history[len(r.history)-1], nil
+	}
+	n, err := strconv.Atoi(ref)
+	if err != nil || n < 1 || n > len(r.history) {
+		return "", fmt.Errorf("no history entry %q", ref)
+	}
+	return r.history[n-1], nil
+}
+
+// addHistory records an input and appends it to the history file.
+// Multi-line inputs are stored with their newlines escaped.
+func (r *REPL) addHistory(src string) {
+	r.history = append(r.history, src)
+	if r.historyPath == "" {
+		return
+	}
+	f, err := os.OpenFile(r.historyPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return // History is a convenience; never fail the session over it
+	}
+	defer f.Close()
+	fmt.Fprintln(f, strconv.Quote(src))
+}
+
+// loadHistory reads the entries saved by previous sessions.
+func (r *REPL) loadHistory() {
+	data, err := os.ReadFile(r.historyPath)
+	if err != nil {
+		return
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if entry, err := strconv.Unquote(line); err == nil {
+			r.history = append(r.history, entry)
+		}
+	}
+}
+
+// incomplete reports whether src ends inside an unclosed bracket, string or
+// block comment, so the REPL should keep reading lines.
+func incomplete(src string) bool {
+	depth := 0
+	for i := 0; i < len(src); i++ {
+		switch c := src[i]; c {
+		case '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case '/':
+			if strings.HasPrefix(src[i:], "/*") {
+				end := strings.Index(src[i+2:], "*/")
+				if end < 0 {
+					return true
+				}
+				i += 2 + end + 1
+			}
+		case '"', '\'':
+			quote := string(c)
+			if strings.HasPrefix(src[i:], strings.Repeat(quote, 3)) {
+				end := strings.Index(src[i+3:], strings.Repeat(quote, 3))
+				if end < 0 {
+					return true
+				}
+				i += 3 + end + 2
+				continue
+			}
+			for i++; i < len(src) && src[i] != c && src[i] != '\n'; i++ {
+				if src[i] == '\\' {
+					i++
+				}
+			}
+		case '{', '(', '[':
+			depth++
+		case '}', ')', ']':
+			depth--
+		}
+	}
+	return depth > 0
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/SethGK/Inscript/internal/compiler"
+	"github.com/SethGK/Inscript/internal/types"
+)
+
+// VMTracer prints every instruction, call, return and error of a run, indented
+// by call depth:
+//
+//	call add(1, 2)
+//	  add 0000 OpGetLocal 0        []
+//	  add 0002 OpGetLocal 1        [1]
+//	  add 0004 OpAdd               [1 2]
+//	  add 0005 OpReturnValue       [3]
+//	return add -> 3
+type VMTracer struct {
+	out   io.Writer
+	depth int
+}
+
+func NewVMTracer(out io.Writer) *VMTracer {
+	return &VMTracer{out: out}
+}
+
+func (t *VMTracer) OnInstruction(fn *types.CompiledFunction, offset int, op compiler.Opcode, operands []int, stack []types.Value, depth int) {
+	t.depth = depth
+	ins := op.String()
+	for _, operand := range operands {
+		ins += fmt.Sprintf(" %d", operand)
+	}
+	fmt.Fprintf(t.out, "%s%s %04d %-18s %s\n", t.indent(), fn.Name, offset, ins, "["+inspectAll(stack, " ")+"]")
+}
+
+func (t *VMTracer) OnCall(callee types.Value, args []types.Value, depth int) {
+	t.depth = depth - 1
+	fmt.Fprintf(t.out, "%scall %s(%s)\n", t.indent(), calleeName(callee), inspectAll(args, ", "))
+}
+
+func (t *VMTracer) OnReturn(callee types.Value, result types.Value, depth int) {
+	t.depth = depth - 1
+	fmt.Fprintf(t.out, "%sreturn %s -> %s\n", t.indent(), calleeName(callee), result.Inspect())
+}
+
+func (t *VMTracer) OnError(err *types.Error, caught bool) {
+	status := "uncaught"
+	if caught {
+		status = "caught"
+	}
+	fmt.Fprintf(t.out, "%serror %s: %s (%s)\n", t.indent(), err.Pos, err.Message, status)
+}
+
+func (t *VMTracer) indent() string {
+	return strings.Repeat("  ", t.depth)
+}
+
+// inspectAll joins the representations of values, showing unset ones as nil.
+func inspectAll(values []types.Value, sep string) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		if v == nil {
+			parts[i] = "nil"
+			continue
+		}
+		parts[i] = v.Inspect()
+	}
+	return strings.Join(parts, sep)
+}
+
+func calleeName(callee types.Value) string {
+	switch c := callee.(type) {
+	case *types.Closure:
+		return c.Fn.Name
+	case *types.Builtin:
+		return c.Name
+	}
+	return callee.Inspect()
+}
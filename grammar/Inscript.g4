@@ -20,6 +20,8 @@ statement
     | importStmt
     | printStmt
     | block
+    | tryStmt
+    | throwStmt
     ;
 
 block
@@ -31,7 +33,18 @@ block
 exprStmt: expression;
 
 assignment
-    : target (ASSIGN | ADD_ASSIGN | SUB_ASSIGN | MUL_ASSIGN | DIV_ASSIGN | POW_ASSIGN) expression
+    : targetList (ASSIGN | ADD_ASSIGN | SUB_ASSIGN | MUL_ASSIGN | DIV_ASSIGN | POW_ASSIGN) expressionList
+    ;
+
+// Several targets unpack an iterable: a, (b, c), ...rest
+targetList
+    : targetItem (COMMA targetItem)*
+    ;
+
+targetItem
+    : target
+    | LPAREN targetList RPAREN
+    | ELLIPSIS IDENTIFIER
     ;
 
 target
@@ -41,7 +54,7 @@ target
     ;
 
 ifStmt
-    : IF expression block (ELSE block)?
+    : IF expression block (ELSE (ifStmt | block))?
     ;
 
 whileStmt
@@ -49,7 +62,7 @@ whileStmt
     ;
 
 forStmt
-    : FOR IDENTIFIER IN expression block
+    : FOR targetList IN expression block
     ;
 
 funcDef
@@ -69,10 +82,16 @@ typeAnnotation: IDENTIFIER;
 
 breakStmt: BREAK;
 continueStmt: CONTINUE;
-returnStmt: RETURN expression?;
+returnStmt: RETURN expressionList?;
 importStmt: IMPORT STRING;
 printStmt: PRINT LPAREN (expression (COMMA expression)*)? RPAREN;
 
+tryStmt
+    : TRY block (CATCH IDENTIFIER block (FINALLY block)? | FINALLY block)
+    ;
+
+throwStmt: THROW expression;
+
 // Left-recursive expression rules, unchanged
 expression
     : unaryExpr                         #unaryExpression
@@ -112,7 +131,15 @@ postfixExpr
     | postfixExpr DOT IDENTIFIER                      #attrPostfix
     ;
 
-argList: expression (COMMA expression)*;
+argList: argument (COMMA argument)*;
+
+// Several expressions build a tuple: return a, b
+expressionList: expression (COMMA expression)*;
+
+argument
+    : IDENTIFIER ASSIGN expression
+    | expression
+    ;
 
 primary
     : literal
@@ -121,6 +148,8 @@ primary
     | LPAREN expression (COMMA expression)+ RPAREN     // tuple
     | listLiteral
     | tableLiteral
+    | functionLiteral
+    | arrowFunction
     ;
 
 literal
@@ -131,6 +160,15 @@ literal
     | NIL
     ;
 
+functionLiteral
+    : FUNCTION LPAREN paramList? RPAREN (ARROW typeAnnotation)? block
+    ;
+
+// Short form whose body is a single expression: x -> x * 2, (a, b) -> a + b
+arrowFunction
+    : (IDENTIFIER | LPAREN paramList? RPAREN) ARROW expression
+    ;
+
 listLiteral: LBRACK (expression (COMMA expression)*)? RBRACK;
 tableLiteral: LBRACE (tableKeyValue (COMMA tableKeyValue)*)? RBRACE;
 tableKeyValue: tableKey ASSIGN expression;
@@ -194,6 +232,11 @@ DOT: '.';
 COLON: ':';
 ELLIPSIS: '...';
 
+TRY: 'try';
+THROW: 'throw';
+CATCH: 'catch';
+FINALLY: 'finally';
+
 IDENTIFIER: [a-zA-Z_][a-zA-Z0-9_]*;
 NUMBER: [0-9]+ ('.' [0-9]+)?;
 STRING
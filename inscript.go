@@ -0,0 +1,254 @@
+// Package inscript embeds the Inscript scripting language in Go programs.
+//
+// A script is compiled once with Compile and may then be run any number of
+// times. Host values and Go functions are made visible to the script as
+// globals through CompileOptions, and globals can be read back or replaced by
+// name between runs:
+//
+//	prog, err := inscript.Compile(src,
+//		inscript.WithGlobal("limit", 10),
+//		inscript.WithFunction("log", func(args ...any) (any, error) {
+//			fmt.Println(args...)
+//			return nil, nil
+//		}),
+//	)
+//	if err != nil { ... }
+//	if err := prog.Run(ctx, nil); err != nil { ... }
+//	result, ok := prog.Get("result")
+//
+// Values cross the boundary as plain Go values: nil, bool, int64 (any Go
+// integer kind is accepted), float64 (or float32), string, []any for lists and
+// tuples, and map[string]any for tables, or map[any]any for tables with keys
+// that are not all strings. Script values with no Go counterpart, such as
+// functions, are returned as Object and may be passed back unchanged.
+package inscript
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/SethGK/Inscript/internal/ast"
+	"github.com/SethGK/Inscript/internal/compiler"
+	"github.com/SethGK/Inscript/internal/loader"
+	"github.com/SethGK/Inscript/internal/types"
+	"github.com/SethGK/Inscript/internal/vm"
+)
+
+// Function is a host Go function callable from scripts. Arguments and the
+// result are converted as described in the package documentation.
+type Function func(args ...any) (any, error)
+
+// CompileOption configures Compile.
+type CompileOption func(*compileConfig)
+
+type compileConfig struct {
+	filename string
+	globals  []hostGlobal
+}
+
+// hostGlobal is a global declared by the host before compilation.
+type hostGlobal struct {
+	name  string
+	value any
+}
+
+// WithFilename sets the file name reported in compile and runtime error positions.
+func WithFilename(name string) CompileOption {
+	return func(c *compileConfig) {
+		c.filename = name
+	}
+}
+
+// WithGlobal declares a global variable with an initial value. The script can
+// read and reassign it like any global it defines itself.
+func WithGlobal(name string, value any) CompileOption {
+	return func(c *compileConfig) {
+		c.globals = append(c.globals, hostGlobal{name: name, value: value})
+	}
+}
+
+// WithFunction exposes a Go function to the script as a global named name.
+func WithFunction(name string, fn Function) CompileOption {
+	return WithGlobal(name, fn)
+}
+
+// RunOptions configures a single Run. A nil *RunOptions uses the defaults.
+// The limits bound scripts that cannot be trusted to finish; zero means no
+// limit. Each limit fails the run with its own error type, found with errors.As.
+type RunOptions struct {
+	Stdout      io.Writer // Destination of print statements; nil means os.Stdout
+	SearchPaths []string  // Directories searched by import statements
+
+	MaxInstructions int64         // Fails with *InstructionLimitError
+	Timeout         time.Duration // Fails with *InterruptError, as does canceling the context
+	MaxSize         int           // Longest list, tuple, table or string an operator, builtin or method builds; fails with *SizeLimitError
+	MaxCallDepth    int           // Deepest nesting of calls; fails with *CallDepthError
+}
+
+// Errors reported when a run exceeds the limits in RunOptions or its context
+// ends. Scripts can catch size and call depth errors with try, but not the
+// others.
+type (
+	InstructionLimitError = vm.InstructionLimitError
+	InterruptError        = vm.InterruptError
+	SizeLimitError        = vm.SizeLimitError
+	CallDepthError        = vm.CallDepthError
+)
+
+// Program is a compiled script together with its global variables.
+// A Program is not safe for concurrent use.
+type Program struct {
+	bytecode *compiler.Bytecode
+	globals  []types.Value
+	names    map[string]int // Global name -> slot in globals
+}
+
+// Compile parses and compiles src. Globals and functions declared through
+// opts are defined before the script, so it may refer to them by name.
+func Compile(src string, opts ...CompileOption) (*Program, error) {
+	var cfg compileConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	program, err := ast.ParseFile(cfg.filename, src)
+	if err != nil {
+		return nil, fmt.Errorf("parse error: %w", err)
+	}
+
+	// Host globals take the first slots so their values can be placed before
+	// the script's own globals are known.
+	symbols := compiler.NewGlobalSymbolTable()
+	var initial []types.Value
+	for _, g := range cfg.globals {
+		value, err := hostValue(g.name, g.value)
+		if err != nil {
+			return nil, err
+		}
+		if sym, ok := symbols.Resolve(g.name); ok && sym.Kind == compiler.Global {
+			initial[sym.Index] = value // Declared twice: the last value wins
+			continue
+		}
+		symbols.DefineGlobal(g.name)
+		initial = append(initial, value)
+	}
+
+	bytecode, err := compiler.NewWithState(symbols, make([]types.Value, 0)).Compile(program)
+	if err != nil {
+		return nil, fmt.Errorf("compilation error: %w", err)
+	}
+
+	globals := make([]types.Value, bytecode.NumGlobals)
+	copy(globals, initial)
+	names := make(map[string]int, len(bytecode.GlobalNames))
+	for i, name := range bytecode.GlobalNames {
+		names[name] = i
+	}
+
+	return &Program{bytecode: bytecode, globals: globals, names: names}, nil
+}
+
+// hostValue converts a host global, wrapping Go functions as builtins.
+func hostValue(name string, value any) (types.Value, error) {
+	if fn, ok := value.(Function); ok {
+		return wrapFunction(name, fn), nil
+	}
+	if fn, ok := value.(func(args ...any) (any, error)); ok {
+		return wrapFunction(name, fn), nil
+	}
+	v, err := toValue(value)
+	if err != nil {
+		return nil, fmt.Errorf("global %s: %w", name, err)
+	}
+	return v, nil
+}
+
+// wrapFunction adapts a host Function to the VM's builtin calling convention.
+func wrapFunction(name string, fn Function) *types.Builtin {
+	return &types.Builtin{
+		Name: name,
+		Fn: func(args ...types.Value) (types.Value, error) {
+			hostArgs := make([]any, len(args))
+			for i, arg := range args {
+				hostArgs[i] = fromValue(arg)
+			}
+			result, err := fn(hostArgs...)
+			if err != nil {
+				return nil, err
+			}
+			return toValue(result)
+		},
+	}
+}
+
+// Run executes the program. Globals keep their values between runs, so a
+// second Run sees whatever the first one left behind. The run stops with an
+// *InterruptError when ctx is canceled or its deadline passes.
+func (p *Program) Run(ctx context.Context, opts *RunOptions) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("runtime error: %w", &InterruptError{Err: err})
+	}
+	if opts == nil {
+		opts = &RunOptions{}
+	}
+
+	// The timeout covers imported modules too, so it goes into the context.
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+	limits := vm.Limits{
+		MaxInstructions: opts.MaxInstructions,
+		MaxSize:         opts.MaxSize,
+		MaxCallDepth:    opts.MaxCallDepth,
+	}
+	machine := vm.NewWithGlobals(p.bytecode, p.globals)
+	machine.SetLimits(limits)
+	imports := loader.New("", opts.SearchPaths...)
+	imports.Limits = limits
+	imports.Context = ctx
+	if opts.Stdout != nil {
+		machine.SetOutput(opts.Stdout)
+		imports.Output = opts.Stdout
+	}
+	machine.SetImporter(imports)
+
+	if err := machine.RunContext(ctx); err != nil {
+		return fmt.Errorf("runtime error: %w", err)
+	}
+	return nil
+}
+
+// Get returns the value of the global called name, converted with fromValue.
+// It reports false if the script has no such global or it has not been
+// assigned yet.
+func (p *Program) Get(name string) (any, bool) {
+	idx, ok := p.names[name]
+	if !ok || p.globals[idx] == nil {
+		return nil, false
+	}
+	return fromValue(p.globals[idx]), true
+}
+
+// Set assigns the global called name. Only globals known at compile time, those
+// the script defines or declared with WithGlobal, can be set.
+func (p *Program) Set(name string, value any) error {
+	idx, ok := p.names[name]
+	if !ok {
+		return fmt.Errorf("undefined global: %s", name)
+	}
+	v, err := hostValue(name, value)
+	if err != nil {
+		return err
+	}
+	p.globals[idx] = v
+	return nil
+}
+
+// Globals returns the names of all globals in slot order.
+func (p *Program) Globals() []string {
+	return append([]string(nil), p.bytecode.GlobalNames...)
+}
@@ -0,0 +1,233 @@
+package inscript
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SethGK/Inscript/internal/types"
+)
+
+func TestCompileAndRun(t *testing.T) {
+	var calls [][]any
+	prog, err := Compile(`
+total = 0
+for i in range(limit) { total += scale(i) }
+print("total", total)
+`,
+		WithGlobal("limit", 4),
+		WithFunction("scale", func(args ...any) (any, error) {
+			calls = append(calls, args)
+			return args[0].(int64) * 10, nil
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := prog.Run(context.Background(), &RunOptions{Stdout: &out}); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "total 60\n" {
+		t.Errorf("printed %q, want %q", out.String(), "total 60\n")
+	}
+	if got, ok := prog.Get("total"); !ok || got != int64(60) {
+		t.Errorf("Get(total) = %v, %v; want 60, true", got, ok)
+	}
+	if want := [][]any{{int64(0)}, {int64(1)}, {int64(2)}, {int64(3)}}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("scale called with %v, want %v", calls, want)
+	}
+}
+
+func TestGetSet(t *testing.T) {
+	prog, err := Compile(`
+if false { never = 1 }
+count = count + 1
+`, WithGlobal("count", 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := prog.Globals(), []string{"count", "never"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Globals() = %v, want %v", got, want)
+	}
+	if _, ok := prog.Get("never"); ok {
+		t.Error("Get reported an unassigned global")
+	}
+	if _, ok := prog.Get("missing"); ok {
+		t.Error("Get reported a global the script does not have")
+	}
+	if err := prog.Set("missing", 1); err == nil {
+		t.Error("Set accepted a global the script does not have")
+	}
+	if err := prog.Set("count", struct{}{}); err == nil {
+		t.Error("Set accepted a value with no Inscript counterpart")
+	}
+
+	// Globals keep their values between runs.
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if err := prog.Run(ctx, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, _ := prog.Get("count"); got != int64(2) {
+		t.Errorf("count = %v after two runs, want 2", got)
+	}
+	if err := prog.Set("count", 40); err != nil {
+		t.Fatal(err)
+	}
+	if err := prog.Run(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := prog.Get("count"); got != int64(41) {
+		t.Errorf("count = %v after Set(40) and a run, want 41", got)
+	}
+}
+
+func TestHostFunctionError(t *testing.T) {
+	prog, err := Compile("fail()", WithFunction("fail", func(args ...any) (any, error) {
+		return nil, errors.New("host refused")
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = prog.Run(context.Background(), nil)
+	if err == nil || !strings.Contains(err.Error(), "host refused") {
+		t.Errorf("got %v, want the host function's error", err)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	if _, err := Compile("x = (", WithFilename("broken.ins")); err == nil || !strings.Contains(err.Error(), "broken.ins:1:") {
+		t.Errorf("syntax error: got %v, want a position in broken.ins", err)
+	}
+	if _, err := Compile("x = 1", WithGlobal("bad", make(chan int))); err == nil || !strings.Contains(err.Error(), "global bad") {
+		t.Errorf("unconvertible global: got %v, want an error naming the global", err)
+	}
+}
+
+func TestValueConversion(t *testing.T) {
+	tests := []struct {
+		in, out any
+	}{
+		{nil, nil},
+		{true, true},
+		{7, int64(7)},
+		{int8(-8), int64(-8)},
+		{uint8(8), int64(8)},
+		{uint64(math.MaxInt64), int64(math.MaxInt64)},
+		{float32(0.5), 0.5},
+		{2.25, 2.25},
+		{"text", "text"},
+		{[]any{1, "a", []any{nil}}, []any{int64(1), "a", []any{nil}}},
+		{map[string]any{"a": 1, "b": []any{true}}, map[string]any{"a": int64(1), "b": []any{true}}},
+		{map[any]any{1: "one", "two": 2}, map[any]any{int64(1): "one", "two": int64(2)}},
+	}
+	for _, tt := range tests {
+		v, err := toValue(tt.in)
+		if err != nil {
+			t.Errorf("toValue(%#v): %v", tt.in, err)
+			continue
+		}
+		if got := fromValue(v); !reflect.DeepEqual(got, tt.out) {
+			t.Errorf("fromValue(toValue(%#v)) = %#v, want %#v", tt.in, got, tt.out)
+		}
+	}
+
+	for _, bad := range []any{uint64(math.MaxInt64) + 1, uint(math.MaxUint64), struct{}{}, map[any]any{"k": func() {}}, map[any]any{Object{value: types.NewList()}: nil}} {
+		if _, err := toValue(bad); err == nil {
+			t.Errorf("toValue(%#v) succeeded, want an error", bad)
+		}
+	}
+}
+
+func TestObjectRoundTrip(t *testing.T) {
+	prog, err := Compile(`
+inc = (x) -> x + 1
+result = nil
+if f != nil { result = f(41) }
+`, WithGlobal("f", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err := prog.Run(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	inc, _ := prog.Get("inc")
+	obj, ok := inc.(Object)
+	if !ok {
+		t.Fatalf("Get(inc) = %T, want an Object", inc)
+	}
+	if err := prog.Set("f", obj); err != nil {
+		t.Fatal(err)
+	}
+	if err := prog.Run(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := prog.Get("result"); got != int64(42) {
+		t.Errorf("result = %v, want 42", got)
+	}
+}
+
+func TestRunLimits(t *testing.T) {
+	run := func(src string, opts *RunOptions) error {
+		t.Helper()
+		prog, err := Compile(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return prog.Run(context.Background(), opts)
+	}
+
+	err := run("while true { }", &RunOptions{MaxInstructions: 1000})
+	var limitErr *InstructionLimitError
+	if !errors.As(err, &limitErr) {
+		t.Errorf("MaxInstructions: got %v, want an *InstructionLimitError", err)
+	}
+
+	err = run("x = range(100)", &RunOptions{MaxSize: 10})
+	var sizeErr *SizeLimitError
+	if !errors.As(err, &sizeErr) || sizeErr.Limit != 10 {
+		t.Errorf("MaxSize: got %v, want a *SizeLimitError", err)
+	}
+
+	err = run("f = nil\nfunction f(n) { return f(n + 1) }\nf(0)", &RunOptions{MaxCallDepth: 50})
+	var depthErr *CallDepthError
+	if !errors.As(err, &depthErr) || depthErr.Limit != 50 {
+		t.Errorf("MaxCallDepth: got %v, want a *CallDepthError", err)
+	}
+
+	err = run("while true { }", &RunOptions{Timeout: 10 * time.Millisecond})
+	var interrupt *InterruptError
+	if !errors.As(err, &interrupt) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Timeout: got %v, want an *InterruptError for the deadline", err)
+	}
+}
+
+func TestRunContext(t *testing.T) {
+	prog, err := Compile("while true { }")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = prog.Run(ctx, nil)
+	var interrupt *InterruptError
+	if !errors.As(err, &interrupt) || !errors.Is(err, context.Canceled) {
+		t.Errorf("canceled context: got %v, want an *InterruptError", err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = prog.Run(ctx, nil)
+	if !errors.As(err, &interrupt) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("context deadline: got %v, want an *InterruptError", err)
+	}
+}
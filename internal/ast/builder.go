@@ -57,6 +57,10 @@ func (v *ASTBuilder) VisitStatement(ctx *parser.StatementContext) interface{} {
 		return ctx.PrintStmt().Accept(v)
 	case ctx.Block() != nil:
 		return ctx.Block().Accept(v)
+	case ctx.TryStmt() != nil:
+		return ctx.TryStmt().Accept(v)
+	case ctx.ThrowStmt() != nil:
+		return ctx.ThrowStmt().Accept(v)
 	default:
 		fmt.Printf("WARNING: VisitStatement encountered unexpected child context: %T at line %d\n", ctx.GetChild(0), ctx.GetStart().GetLine())
 		return nil
@@ -89,16 +93,16 @@ func (v *ASTBuilder) VisitExprStmt(ctx *parser.ExprStmtContext) interface{} {
 
 // VisitAssignment builds an AssignStmt node.
 func (v *ASTBuilder) VisitAssignment(ctx *parser.AssignmentContext) interface{} {
-	target := ctx.Target().Accept(v).(Expression)
+	target := ctx.TargetList().Accept(v).(Expression)
 	antlrOpToken := ctx.GetChild(1).(antlr.TerminalNode).GetSymbol()
 	opToken := Token{
 		Type:    antlrOpToken.GetTokenType(),
 		Pos:     token.Pos(antlrOpToken.GetStart()),
 		Literal: antlrOpToken.GetText(),
 	}
-	value := ctx.Expression().Accept(v).(Expression)
+	value := ctx.ExpressionList().Accept(v).(Expression)
 	switch target.(type) {
-	case *Identifier, *IndexExpr, *AttrExpr:
+	case *Identifier, *IndexExpr, *AttrExpr, *TupleTarget:
 		// Valid target types
 	default:
 		fmt.Printf("ERROR: Invalid assignment target type %T at line %d\n", target, ctx.GetStart().GetLine())
@@ -113,6 +117,45 @@ func (v *ASTBuilder) VisitAssignment(ctx *parser.AssignmentContext) interface{}
 	}
 }
 
+// VisitTargetList builds a single target, or a TupleTarget if there are
+// several or the only one is a rest target.
+func (v *ASTBuilder) VisitTargetList(ctx *parser.TargetListContext) interface{} {
+	items := ctx.AllTargetItem()
+	elements := make([]Expression, len(items))
+	for i, item := range items {
+		elements[i] = item.Accept(v).(Expression)
+	}
+	if _, isRest := elements[0].(*RestTarget); len(elements) == 1 && !isRest {
+		return elements[0]
+	}
+	return &TupleTarget{PosToken: token.Pos(ctx.GetStart().GetStart()), Elements: elements}
+}
+
+// VisitTargetItem builds a target, a nested target list or a rest target.
+func (v *ASTBuilder) VisitTargetItem(ctx *parser.TargetItemContext) interface{} {
+	switch {
+	case ctx.Target() != nil:
+		return ctx.Target().Accept(v)
+	case ctx.TargetList() != nil:
+		return ctx.TargetList().Accept(v)
+	default:
+		return &RestTarget{PosToken: token.Pos(ctx.ELLIPSIS().GetSymbol().GetStart()), Name: ctx.IDENTIFIER().GetText()}
+	}
+}
+
+// VisitExpressionList builds a single expression, or a TupleLiteral of several.
+func (v *ASTBuilder) VisitExpressionList(ctx *parser.ExpressionListContext) interface{} {
+	exprs := ctx.AllExpression()
+	if len(exprs) == 1 {
+		return exprs[0].Accept(v)
+	}
+	elements := make([]Expression, len(exprs))
+	for i, exprCtx := range exprs {
+		elements[i] = exprCtx.Accept(v).(Expression)
+	}
+	return &TupleLiteral{PosToken: token.Pos(ctx.GetStart().GetStart()), Elements: elements}
+}
+
 // VisitTarget builds the Expression node for the assignment target.
 func (v *ASTBuilder) VisitTarget(ctx *parser.TargetContext) interface{} {
 	if ctx.IDENTIFIER() != nil && ctx.PostfixExpr() == nil {
@@ -142,7 +185,17 @@ func (v *ASTBuilder) VisitIfStmt(ctx *parser.IfStmtContext) interface{} {
 	var elseIfs []ElseIf
 	var elseBlock *BlockStmt
 	if ctx.ELSE() != nil {
-		if len(ctx.AllBlock()) > 1 {
+		if nested := ctx.IfStmt(); nested != nil {
+			// The grammar nests `else if` chains; flatten them into this statement.
+			inner := nested.Accept(v).(*IfStmt)
+			elseIfs = append(elseIfs, ElseIf{
+				PosToken: token.Pos(ctx.ELSE().GetSymbol().GetStart()),
+				Cond:     inner.Cond,
+				Body:     inner.Then,
+			})
+			elseIfs = append(elseIfs, inner.ElseIfs...)
+			elseBlock = inner.Else
+		} else if len(ctx.AllBlock()) > 1 {
 			elseBlock = ctx.Block(1).Accept(v).(*BlockStmt)
 		} else {
 			fmt.Printf("ERROR: ELSE keyword without a following block at line %d\n", ctx.ELSE().GetSymbol().GetLine())
@@ -168,10 +221,10 @@ func (v *ASTBuilder) VisitWhileStmt(ctx *parser.WhileStmtContext) interface{} {
 
 // VisitForStmt builds a ForStmt node.
 func (v *ASTBuilder) VisitForStmt(ctx *parser.ForStmtContext) interface{} {
-	varNameToken := ctx.IDENTIFIER().GetSymbol()
+	target := ctx.TargetList().Accept(v).(Expression)
 	iter := ctx.Expression().Accept(v).(Expression)
 	body := ctx.Block().Accept(v).(*BlockStmt)
-	return &ForStmt{PosToken: token.Pos(ctx.GetStart().GetStart()), Variable: varNameToken.GetText(), Iterable: iter, Body: body}
+	return &ForStmt{PosToken: token.Pos(ctx.GetStart().GetStart()), Target: target, Iterable: iter, Body: body}
 }
 
 // VisitFuncDef builds a FunctionDef statement node.
@@ -271,8 +324,8 @@ func (v *ASTBuilder) VisitContinueStmt(ctx *parser.ContinueStmtContext) interfac
 // VisitReturnStmt builds a ReturnStmt node.
 func (v *ASTBuilder) VisitReturnStmt(ctx *parser.ReturnStmtContext) interface{} {
 	var expr Expression
-	if ctx.Expression() != nil {
-		expr = ctx.Expression().Accept(v).(Expression)
+	if ctx.ExpressionList() != nil {
+		expr = ctx.ExpressionList().Accept(v).(Expression)
 	}
 	return &ReturnStmt{PosToken: token.Pos(ctx.GetStart().GetStart()), Expr: expr}
 }
@@ -303,6 +356,30 @@ func (v *ASTBuilder) VisitPrintStmt(ctx *parser.PrintStmtContext) interface{} {
 	return &PrintStmt{PosToken: token.Pos(ctx.GetStart().GetStart()), Exprs: exprs}
 }
 
+// VisitTryStmt builds a TryStmt node.
+func (v *ASTBuilder) VisitTryStmt(ctx *parser.TryStmtContext) interface{} {
+	stmt := &TryStmt{
+		PosToken: token.Pos(ctx.GetStart().GetStart()),
+		Body:     ctx.Block(0).Accept(v).(*BlockStmt),
+	}
+	next := 1
+	if ctx.CATCH() != nil {
+		stmt.CatchVar = ctx.IDENTIFIER().GetText()
+		stmt.Catch = ctx.Block(next).Accept(v).(*BlockStmt)
+		next++
+	}
+	if ctx.FINALLY() != nil {
+		stmt.Finally = ctx.Block(next).Accept(v).(*BlockStmt)
+	}
+	return stmt
+}
+
+// VisitThrowStmt builds a ThrowStmt node.
+func (v *ASTBuilder) VisitThrowStmt(ctx *parser.ThrowStmtContext) interface{} {
+	expr := ctx.Expression().Accept(v).(Expression)
+	return &ThrowStmt{PosToken: token.Pos(ctx.GetStart().GetStart()), Expr: expr}
+}
+
 // --- Expression Visitor Methods (Using Labeled Alternatives) ---
 
 // VisitUnaryExpression handles the base case for expressions, visiting a unaryExpr.
@@ -659,14 +736,24 @@ func (v *ASTBuilder) VisitAttrPostfix(ctx *parser.AttrPostfixContext) interface{
 // VisitArgList handles a comma-separated list of arguments.
 func (v *ASTBuilder) VisitArgList(ctx *parser.ArgListContext) interface{} {
 	var args []Expression
-	for _, exprCtx := range ctx.AllExpression() { // Corrected: Use AllExpression()
-		if exprNode, ok := exprCtx.Accept(v).(Expression); ok {
-			args = append(args, exprNode)
+	for _, argCtx := range ctx.AllArgument() {
+		if argNode, ok := argCtx.Accept(v).(Expression); ok {
+			args = append(args, argNode)
 		}
 	}
 	return args
 }
 
+// VisitArgument handles a positional argument or a keyword argument (`name = value`).
+func (v *ASTBuilder) VisitArgument(ctx *parser.ArgumentContext) interface{} {
+	value := ctx.Expression().Accept(v).(Expression)
+	if ctx.IDENTIFIER() == nil {
+		return value
+	}
+	nameToken := ctx.IDENTIFIER().GetSymbol()
+	return &KeywordArg{PosToken: token.Pos(nameToken.GetStart()), Name: nameToken.GetText(), Value: value}
+}
+
 // --- Primary Expression Visitor Methods ---
 
 // VisitLiteral handles literal expressions.
@@ -730,10 +817,56 @@ func (v *ASTBuilder) VisitPrimary(ctx *parser.PrimaryContext) interface{} {
 		return ctx.ListLiteral().Accept(v)
 	} else if ctx.TableLiteral() != nil {
 		return ctx.TableLiteral().Accept(v)
+	} else if ctx.FunctionLiteral() != nil {
+		return ctx.FunctionLiteral().Accept(v)
+	} else if ctx.ArrowFunction() != nil {
+		return ctx.ArrowFunction().Accept(v)
 	}
 	return nil
 }
 
+// VisitFunctionLiteral builds a FunctionLiteral node for `function(params) { body }`.
+func (v *ASTBuilder) VisitFunctionLiteral(ctx *parser.FunctionLiteralContext) interface{} {
+	var params []Param
+	if ctx.ParamList() != nil {
+		params = ctx.ParamList().Accept(v).([]Param)
+	}
+
+	var returnType *TypeAnnotation
+	if ctx.TypeAnnotation() != nil {
+		returnType = ctx.TypeAnnotation().Accept(v).(*TypeAnnotation)
+	}
+
+	return &FunctionLiteral{
+		PosToken:   token.Pos(ctx.GetStart().GetStart()),
+		Params:     params,
+		ReturnType: returnType,
+		Body:       ctx.Block().Accept(v).(*BlockStmt),
+	}
+}
+
+// VisitArrowFunction builds a FunctionLiteral node for `(params) -> expr` or
+// `param -> expr`. The body is a block that returns the expression.
+func (v *ASTBuilder) VisitArrowFunction(ctx *parser.ArrowFunctionContext) interface{} {
+	var params []Param
+	if ctx.IDENTIFIER() != nil {
+		idToken := ctx.IDENTIFIER().GetSymbol()
+		params = []Param{{PosToken: token.Pos(idToken.GetStart()), Name: idToken.GetText()}}
+	} else if ctx.ParamList() != nil {
+		params = ctx.ParamList().Accept(v).([]Param)
+	}
+
+	expr := ctx.Expression().Accept(v).(Expression)
+	return &FunctionLiteral{
+		PosToken: token.Pos(ctx.GetStart().GetStart()),
+		Params:   params,
+		Body: &BlockStmt{
+			PosToken: expr.Pos(),
+			Stmts:    []Statement{&ReturnStmt{PosToken: expr.Pos(), Expr: expr}},
+		},
+	}
+}
+
 // VisitListLiteral builds a ListLiteral node.
 func (v *ASTBuilder) VisitListLiteral(ctx *parser.ListLiteralContext) interface{} {
 	var elements []Expression
@@ -0,0 +1,45 @@
+package ast
+
+import (
+	"go/token"
+	"sort"
+	"unicode/utf8"
+)
+
+// File maps the character offsets stored in node positions back to lines and
+// columns of the source they were parsed from.
+type File struct {
+	Name  string // File name used in messages; empty for anonymous sources
+	lines []int  // Character offset of the first character of each line
+}
+
+// NewFile indexes the line starts of src. Offsets are counted in characters,
+// matching the positions reported by the ANTLR input stream.
+func NewFile(name, src string) *File {
+	f := &File{Name: name, lines: []int{0}}
+	offset := 0
+	for len(src) > 0 {
+		r, size := utf8.DecodeRuneInString(src)
+		src = src[size:]
+		offset++
+		if r == '\n' {
+			f.lines = append(f.lines, offset)
+		}
+	}
+	return f
+}
+
+// Position converts a node position to a file name, line and column, all 1-based.
+func (f *File) Position(pos token.Pos) token.Position {
+	offset := int(pos)
+	line := sort.Search(len(f.lines), func(i int) bool { return f.lines[i] > offset }) - 1
+	if line < 0 {
+		line = 0
+	}
+	return token.Position{
+		Filename: f.Name,
+		Offset:   offset,
+		Line:     line + 1,
+		Column:   offset - f.lines[line] + 1,
+	}
+}
@@ -35,6 +35,7 @@ type Token struct {
 type Program struct {
 	Stmts    []Statement
 	PosToken token.Pos // Position of the first token (usually the start of the file)
+	File     *File     // Source the program was parsed from, for resolving positions
 }
 
 func (p *Program) Pos() token.Pos { return p.PosToken }
@@ -60,7 +61,7 @@ func (e *ExprStmt) Pos() token.Pos { return e.PosToken }
 
 // AssignStmt represents an assignment statement: `target op value`.
 type AssignStmt struct {
-	Target   Expression // Target can be Identifier, IndexExpr, AttrExpr
+	Target   Expression // Target can be Identifier, IndexExpr, AttrExpr, TupleTarget
 	Op       Token      // Assignment operator (using custom Token struct)
 	Value    Expression
 	PosToken token.Pos // Position of the target
@@ -98,9 +99,9 @@ type WhileStmt struct {
 func (w *WhileStmt) stmtNode()      {}
 func (w *WhileStmt) Pos() token.Pos { return w.PosToken }
 
-// ForStmt represents a for-in loop: `for variable in iterable { body }`.
+// ForStmt represents a for-in loop: `for target in iterable { body }`.
 type ForStmt struct {
-	Variable string // The loop variable name
+	Target   Expression // The loop variable, or a TupleTarget unpacking each value
 	Iterable Expression
 	Body     *BlockStmt
 	PosToken token.Pos // Position of the 'for' keyword
@@ -179,6 +180,28 @@ type PrintStmt struct {
 func (p *PrintStmt) stmtNode()      {}
 func (p *PrintStmt) Pos() token.Pos { return p.PosToken }
 
+// TryStmt represents `try { body } catch name { handler } finally { cleanup }`.
+// At least one of Catch and Finally is present.
+type TryStmt struct {
+	Body     *BlockStmt
+	CatchVar string     // Name bound to the caught error (empty if there is no catch)
+	Catch    *BlockStmt // Optional catch block (nil if not present)
+	Finally  *BlockStmt // Optional finally block (nil if not present)
+	PosToken token.Pos  // Position of the 'try' keyword
+}
+
+func (t *TryStmt) stmtNode()      {}
+func (t *TryStmt) Pos() token.Pos { return t.PosToken }
+
+// ThrowStmt represents a throw statement: `throw expression`.
+type ThrowStmt struct {
+	Expr     Expression // The thrown value
+	PosToken token.Pos  // Position of the 'throw' keyword
+}
+
+func (t *ThrowStmt) stmtNode()      {}
+func (t *ThrowStmt) Pos() token.Pos { return t.PosToken }
+
 // --- Expression Nodes ---
 
 // BinaryExpr represents a binary operation: `left operator right`.
@@ -212,6 +235,17 @@ type CallExpr struct {
 func (c *CallExpr) exprNode()      {}
 func (c *CallExpr) Pos() token.Pos { return c.PosToken }
 
+// KeywordArg represents a named argument in a call: `name = value`.
+// It only appears in CallExpr.Args.
+type KeywordArg struct {
+	Name     string
+	Value    Expression
+	PosToken token.Pos // Position of the argument name
+}
+
+func (k *KeywordArg) exprNode()      {}
+func (k *KeywordArg) Pos() token.Pos { return k.PosToken }
+
 // IndexExpr represents an index access (e.g., list[index], table[key]).
 type IndexExpr struct {
 	Primary  Expression // The expression being indexed (list, table, string)
@@ -314,6 +348,26 @@ type TableField struct {
 
 func (t *TableField) Pos() token.Pos { return t.PosToken } // TableField is not a Statement or Expression
 
+// TupleTarget represents several assignment targets that unpack an iterable:
+// `a, (b, c), ...rest = value`.
+type TupleTarget struct {
+	Elements []Expression // Identifier, IndexExpr, AttrExpr, TupleTarget or RestTarget
+	PosToken token.Pos    // Position of the first target
+}
+
+func (t *TupleTarget) exprNode()      {}
+func (t *TupleTarget) Pos() token.Pos { return t.PosToken }
+
+// RestTarget represents `...name` in a TupleTarget. It takes the values the
+// other targets leave, as a list.
+type RestTarget struct {
+	Name     string
+	PosToken token.Pos // Position of the '...'
+}
+
+func (r *RestTarget) exprNode()      {}
+func (r *RestTarget) Pos() token.Pos { return r.PosToken }
+
 // TupleLiteral represents a tuple literal (e.g., (1, 2, "a")).
 type TupleLiteral struct {
 	Elements []Expression
@@ -323,7 +377,8 @@ type TupleLiteral struct {
 func (t *TupleLiteral) exprNode()      {}
 func (t *TupleLiteral) Pos() token.Pos { return t.PosToken }
 
-// FunctionLiteral represents a function definition used as an expression: `function(params) -> type? { body }`.
+// FunctionLiteral represents a function definition used as an expression: `function(params) -> type? { body }`,
+// or the arrow form `(params) -> expr`, whose body returns expr.
 // Note: This is an expression, distinct from FunctionDef (which is a statement).
 type FunctionLiteral struct {
 	Params     []Param
@@ -0,0 +1,120 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	parser "github.com/SethGK/Inscript/parser/grammar"
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// Parse lexes and parses Inscript source code and builds its AST.
+func Parse(src string) (*Program, error) {
+	return ParseFile("", src)
+}
+
+// ParseFile is like Parse but records filename for positions in error messages.
+// If the source has syntax errors, all of them are returned as SyntaxErrors and
+// no AST is built.
+func ParseFile(filename, src string) (*Program, error) {
+	input := antlr.NewInputStream(src)
+	lexer := parser.NewInscriptLexer(input)
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	p := parser.NewInscriptParser(stream)
+	p.SetErrorHandler(antlr.NewDefaultErrorStrategy())
+
+	// Replace ANTLR's console listeners so errors are collected instead of printed.
+	listener := &syntaxErrorListener{file: filename, lines: strings.Split(src, "\n")}
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(listener)
+	p.RemoveErrorListeners()
+	p.AddErrorListener(listener)
+
+	parseTree := p.Program()
+	if len(listener.errors) > 0 {
+		return nil, listener.errors
+	}
+
+	program, ok := parseTree.Accept(NewASTBuilder()).(*Program)
+	if !ok || program == nil {
+		return nil, fmt.Errorf("failed to build AST")
+	}
+	program.File = NewFile(filename, src)
+	return program, nil
+}
+
+// SyntaxError is a single lexer or parser error.
+type SyntaxError struct {
+	File      string // File name; empty for anonymous sources
+	Line      int    // 1-based line
+	Column    int    // 1-based column, in characters
+	Offending string // Text of the offending token; empty for lexer errors
+	Message   string // Description of the error as reported by the parser
+	Source    string // The source line the error is on
+}
+
+func (e *SyntaxError) Error() string {
+	pos := fmt.Sprintf("%d:%d", e.Line, e.Column)
+	if e.File != "" {
+		pos = e.File + ":" + pos
+	}
+	return pos + ": syntax error: " + e.Message
+}
+
+// Caret renders the source line with a caret under the error column.
+func (e *SyntaxError) Caret() string {
+	var pad strings.Builder
+	for i, r := range []rune(e.Source) {
+		if i >= e.Column-1 {
+			break
+		}
+		if r == '\t' {
+			pad.WriteRune('\t') // Keep tabs so the caret lines up with the source
+		} else {
+			pad.WriteRune(' ')
+		}
+	}
+	return "    " + e.Source + "\n    " + pad.String() + "^"
+}
+
+// SyntaxErrors is the list of syntax errors found in a source, in order.
+type SyntaxErrors []*SyntaxError
+
+// Error renders every error followed by its source line and caret.
+func (errs SyntaxErrors) Error() string {
+	parts := make([]string, len(errs))
+	for i, e := range errs {
+		parts[i] = e.Error() + "\n" + e.Caret()
+	}
+	return strings.Join(parts, "\n")
+}
+
+// syntaxErrorListener collects the errors reported by the lexer and parser.
+type syntaxErrorListener struct {
+	*antlr.DefaultErrorListener
+	file   string
+	lines  []string
+	errors SyntaxErrors
+}
+
+func (l *syntaxErrorListener) SyntaxError(_ antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, _ antlr.RecognitionException) {
+	if n := len(l.errors); n > 0 {
+		last := l.errors[n-1]
+		if last.Line == line && last.Column == column+1 && last.Message == msg {
+			return // Error recovery can report the same error twice
+		}
+	}
+	e := &SyntaxError{
+		File:    l.file,
+		Line:    line,
+		Column:  column + 1,
+		Message: msg,
+	}
+	if line-1 < len(l.lines) {
+		e.Source = strings.TrimRight(l.lines[line-1], "\r")
+	}
+	if token, ok := offendingSymbol.(antlr.Token); ok {
+		e.Offending = token.GetText()
+	}
+	l.errors = append(l.errors, e)
+}
@@ -0,0 +1,526 @@
+package compiler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/SethGK/Inscript/internal/types"
+)
+
+// Assemble builds bytecode from a textual listing in the format written by
+// Disassemble. Offsets at the start of instruction lines and comments after a
+// ';' are ignored, so a listing assembles back into the bytecode it was made
+// from. Hand-written listings may also define labels ("loop:" on a line of its
+// own) and use them in place of jump offsets and handler offsets:
+//
+//	constants:
+//	  0  int 3
+//
+//	function <module>
+//	  globals: n
+//	  OpConstant 0
+//	  OpSetGlobal 0
+//	loop:
+//	  OpGetGlobal 0
+//	  OpJumpNotTruthy done
+//	  ...
+//	  OpJump loop
+//	done:
+//	  OpNull
+//	  OpReturn
+//
+// Operand counts and ranges are checked against the opcode definitions.
+func Assemble(src string) (*Bytecode, error) {
+	a := &assembler{functions: make(map[int]*types.CompiledFunction)}
+	if err := a.parse(src); err != nil {
+		return nil, err
+	}
+	return a.bytecode(), nil
+}
+
+// asmFunction is a function block being assembled.
+type asmFunction struct {
+	fn      *types.CompiledFunction
+	line    int // Line of the block header
+	globals []string
+	hasIdx  bool // Whether the block has a constant index
+
+	labels   map[string]int
+	fixups   []asmFixup
+	handlers []asmHandler
+}
+
+// asmFixup is a jump whose label is resolved once the block is complete.
+type asmFixup struct {
+	line  int
+	at    int // Offset of the jump operand
+	next  int // Offset of the following instruction
+	label string
+}
+
+// asmHandler is a handler line whose offsets may be labels.
+type asmHandler struct {
+	line                      int
+	start, end, target, depth string
+}
+
+type assembler struct {
+	line      int
+	constants []types.Value
+	seen      []bool // Which constant indexes the constants section defined
+	functions map[int]*types.CompiledFunction
+	main      *asmFunction
+	current   *asmFunction
+	inPool    bool
+}
+
+func (a *assembler) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: %s", a.line, fmt.Sprintf(format, args...))
+}
+
+// opcodesByName maps the names printed by Opcode.String back to opcodes.
+var opcodesByName = func() map[string]Opcode {
+	m := make(map[string]Opcode, len(operandWidths))
+	for op := range operandWidths {
+		m[op.String()] = op
+	}
+	return m
+}()
+
+func (a *assembler) parse(src string) error {
+	for i, raw := range strings.Split(src, "\n") {
+		a.line = i + 1
+		line := strings.TrimSpace(stripComment(raw))
+		if line == "" {
+			continue
+		}
+		var err error
+		switch {
+		case line == "constants:":
+			if a.current != nil || a.constants != nil {
+				return a.errorf("the constants section must come first")
+			}
+			a.inPool = true
+		case strings.HasPrefix(line, "function "):
+			a.inPool = false
+			err = a.header(strings.TrimPrefix(line, "function "))
+		case a.inPool:
+			err = a.constant(line)
+		case a.current == nil:
+			err = a.errorf("instruction outside of a function block")
+		case strings.HasSuffix(line, ":") && !strings.ContainsAny(line, " \t"):
+			err = a.label(strings.TrimSuffix(line, ":"))
+		case isAttribute(line):
+			err = a.attribute(line)
+		default:
+			err = a.instruction(line)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return a.finish()
+}
+
+// stripComment removes a ';' comment, ignoring semicolons in quoted strings.
+func stripComment(line string) string {
+	quoted := false
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case c == '\\' && quoted:
+			i++
+		case c == '"':
+			quoted = !quoted
+		case c == ';' && !quoted:
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// constant parses a constant pool entry: "index type value".
+func (a *assembler) constant(line string) error {
+	fields := strings.SplitN(line, " ", 2)
+	idx, err := strconv.Atoi(fields[0])
+	if err != nil || len(fields) < 2 || idx < 0 {
+		return a.errorf("malformed constant %q", line)
+	}
+	kind, text, _ := strings.Cut(strings.TrimSpace(fields[1]), " ")
+	var val types.Value
+	switch kind {
+	case "int":
+		v, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return a.errorf("bad int constant %q", text)
+		}
+		val = types.NewInteger(v)
+	case "float":
+		v, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return a.errorf("bad float constant %q", text)
+		}
+		val = types.NewFloat(v)
+	case "string":
+		v, err := strconv.Unquote(text)
+		if err != nil {
+			return a.errorf("bad string constant %s", text)
+		}
+		val = types.NewString(v)
+	case "function":
+		val = &types.CompiledFunction{Name: text} // Filled in by its function block
+	default:
+		return a.errorf("unknown constant type %q", kind)
+	}
+	for len(a.constants) <= idx {
+		a.constants = append(a.constants, nil)
+		a.seen = append(a.seen, false)
+	}
+	if a.seen[idx] {
+		return a.errorf("constant %d defined twice", idx)
+	}
+	a.constants[idx], a.seen[idx] = val, true
+	return nil
+}
+
+// header starts a function block. The header is the function's signature, as
+// written by Disassemble, optionally followed by "in <file>".
+func (a *assembler) header(text string) error {
+	if err := a.finishFunction(); err != nil {
+		return err
+	}
+	fn := &types.CompiledFunction{}
+	if in := strings.LastIndex(text, " in "); in >= 0 {
+		fn.File = strings.TrimSpace(text[in+4:])
+		text = strings.TrimSpace(text[:in])
+	}
+	f := &asmFunction{fn: fn, line: a.line, labels: make(map[string]int)}
+
+	if text == mainName {
+		if a.main != nil {
+			return a.errorf("second %s block", mainName)
+		}
+		fn.Name = mainName
+		a.main = f
+		a.current = f
+		return nil
+	}
+
+	if arrow := strings.LastIndex(text, ") -> "); arrow >= 0 {
+		fn.ReturnType = strings.TrimSpace(text[arrow+5:])
+		text = text[:arrow+1]
+	}
+	open := strings.IndexByte(text, '(')
+	if open <= 0 || !strings.HasSuffix(text, ")") {
+		return a.errorf("malformed function header %q", text)
+	}
+	fn.Name = text[:open]
+	params := strings.TrimSpace(text[open+1 : len(text)-1])
+	if params != "" {
+		for i, p := range strings.Split(params, ",") {
+			p = strings.TrimSpace(p)
+			if strings.HasPrefix(p, "...") {
+				fn.Variadic = true
+				p = p[3:]
+			} else if fn.Variadic {
+				return a.errorf("parameter after variadic parameter")
+			}
+			if rest, ok := strings.CutSuffix(p, " = ..."); ok {
+				fn.NumDefaults++
+				p = rest
+			} else if fn.NumDefaults > 0 && !fn.Variadic {
+				return a.errorf("parameter without default after parameter with default")
+			}
+			paramName, typ, typed := strings.Cut(p, ":")
+			if typed {
+				if fn.ParamTypes == nil {
+					fn.ParamTypes = make([]string, i)
+				}
+				fn.ParamTypes = append(fn.ParamTypes, strings.TrimSpace(typ))
+			} else if fn.ParamTypes != nil {
+				fn.ParamTypes = append(fn.ParamTypes, "")
+			}
+			fn.ParamNames = append(fn.ParamNames, slotName(strings.TrimSpace(paramName)))
+		}
+	}
+	if fn.ParamTypes != nil {
+		for len(fn.ParamTypes) < len(fn.ParamNames) {
+			fn.ParamTypes = append(fn.ParamTypes, "")
+		}
+	}
+	fn.NumParameters = len(fn.ParamNames)
+	a.current = f
+	return nil
+}
+
+var attributes = []string{"constant", "globals", "locals", "free", "handler"}
+
+func isAttribute(line string) bool {
+	key, _, ok := strings.Cut(line, ":")
+	if !ok {
+		return false
+	}
+	for _, attr := range attributes {
+		if key == attr {
+			return true
+		}
+	}
+	return false
+}
+
+// attribute parses a "key: value" line of a function block.
+func (a *assembler) attribute(line string) error {
+	key, value, _ := strings.Cut(line, ":")
+	value = strings.TrimSpace(value)
+	f := a.current
+	if len(f.fn.Instructions) > 0 {
+		return a.errorf("%s must come before the instructions", key)
+	}
+	switch key {
+	case "constant":
+		if f == a.main {
+			return a.errorf("the main program is not a constant")
+		}
+		idx, err := strconv.Atoi(value)
+		if err != nil || idx < 0 {
+			return a.errorf("bad constant index %q", value)
+		}
+		if _, dup := a.functions[idx]; dup {
+			return a.errorf("second block for constant %d", idx)
+		}
+		f.hasIdx = true
+		a.functions[idx] = f.fn
+	case "globals":
+		if f != a.main {
+			return a.errorf("only the main program has globals")
+		}
+		f.globals = splitNames(value)
+	case "locals":
+		f.fn.LocalNames = splitNames(value)
+		f.fn.NumLocals = len(f.fn.LocalNames)
+	case "free":
+		f.fn.FreeNames = splitNames(value)
+		f.fn.FreeCount = len(f.fn.FreeNames)
+	case "handler":
+		// start-end -> target, depth n
+		span, rest, ok1 := strings.Cut(value, "->")
+		target, depth, ok2 := strings.Cut(rest, ", depth ")
+		start, end, ok3 := strings.Cut(strings.TrimSpace(span), "-")
+		if !ok1 || !ok2 || !ok3 {
+			return a.errorf("malformed handler %q", value)
+		}
+		f.handlers = append(f.handlers, asmHandler{
+			line:   a.line,
+			start:  strings.TrimSpace(start),
+			end:    strings.TrimSpace(end),
+			target: strings.TrimSpace(target),
+			depth:  strings.TrimSpace(depth),
+		})
+	}
+	return nil
+}
+
+func splitNames(value string) []string {
+	if value == "" {
+		return nil
+	}
+	parts := strings.Split(value, ",")
+	for i, p := range parts {
+		parts[i] = slotName(strings.TrimSpace(p))
+	}
+	return parts
+}
+
+// slotName undoes the "#index" placeholder Disassemble prints for unknown names.
+func slotName(s string) string {
+	if strings.HasPrefix(s, "#") {
+		return ""
+	}
+	return s
+}
+
+func (a *assembler) label(name string) error {
+	f := a.current
+	if _, dup := f.labels[name]; dup {
+		return a.errorf("label %q defined twice", name)
+	}
+	f.labels[name] = len(f.fn.Instructions)
+	return nil
+}
+
+// instruction parses "[offset] [line:col] OpName operands...".
+func (a *assembler) instruction(line string) error {
+	f := a.current
+	fields := strings.Fields(line)
+	if len(fields) > 0 && isOffset(fields[0]) {
+		fields = fields[1:]
+	}
+	offset := len(f.fn.Instructions)
+	if len(fields) > 0 && strings.Contains(fields[0], ":") {
+		l, c, _ := strings.Cut(fields[0], ":")
+		ln, err1 := strconv.Atoi(l)
+		col, err2 := strconv.Atoi(c)
+		if err1 != nil || err2 != nil {
+			return a.errorf("bad position %q", fields[0])
+		}
+		f.fn.Lines = append(f.fn.Lines, types.LineEntry{Offset: offset, Line: ln, Column: col})
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		return a.errorf("missing opcode")
+	}
+	op, ok := opcodesByName[fields[0]]
+	if !ok {
+		return a.errorf("unknown opcode %q", fields[0])
+	}
+	widths := operandWidths[op]
+	args := fields[1:]
+	if len(args) != len(widths) {
+		return a.errorf("%s takes %d operand(s), got %d", op, len(widths), len(args))
+	}
+
+	operands := make([]int, len(widths))
+	var label string
+	for i, arg := range args {
+		v, err := strconv.Atoi(arg)
+		if err != nil {
+			if i != 0 || !IsJump(op) {
+				return a.errorf("bad operand %q for %s", arg, op)
+			}
+			label = arg // Resolved when the block is complete
+			continue
+		}
+		if err := checkOperand(op, i, v); err != nil {
+			return a.errorf("%v", err)
+		}
+		operands[i] = v
+	}
+	f.fn.Instructions = append(f.fn.Instructions, Make(op, operands...)...)
+	if label != "" {
+		f.fixups = append(f.fixups, asmFixup{line: a.line, at: offset + 1, next: len(f.fn.Instructions), label: label})
+	}
+	return nil
+}
+
+func isOffset(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// checkOperand reports whether v fits operand i of op. Two-byte operands are
+// read back as signed, so jump offsets may be negative and other operands
+// are limited to the positive half of the range.
+func checkOperand(op Opcode, i, v int) error {
+	lo, hi := 0, 0
+	switch operandWidths[op][i] {
+	case 1:
+		hi = 255
+	case 2:
+		hi = 32767
+		if i == 0 && IsJump(op) {
+			lo = -32768
+		}
+	}
+	if v < lo || v > hi {
+		return fmt.Errorf("operand %d of %s out of range [%d, %d]: %d", i+1, op, lo, hi, v)
+	}
+	return nil
+}
+
+// finishFunction resolves the labels of the current block.
+func (a *assembler) finishFunction() error {
+	f := a.current
+	if f == nil {
+		return nil
+	}
+	for _, fix := range f.fixups {
+		target, ok := f.labels[fix.label]
+		if !ok {
+			a.line = fix.line
+			return a.errorf("undefined label %q", fix.label)
+		}
+		offset := target - fix.next
+		if offset < -32768 || offset > 32767 {
+			a.line = fix.line
+			return a.errorf("jump to %q out of range", fix.label)
+		}
+		f.fn.Instructions[fix.at] = byte(offset >> 8)
+		f.fn.Instructions[fix.at+1] = byte(offset)
+	}
+	for _, h := range f.handlers {
+		a.line = h.line
+		var vals [4]int
+		for i, s := range []string{h.start, h.end, h.target, h.depth} {
+			v, err := strconv.Atoi(s)
+			if err != nil {
+				if i == 3 {
+					return a.errorf("bad handler depth %q", s)
+				}
+				target, ok := f.labels[s]
+				if !ok {
+					return a.errorf("undefined label %q", s)
+				}
+				v = target
+			}
+			vals[i] = v
+		}
+		f.fn.Handlers = append(f.fn.Handlers, types.Handler{Start: vals[0], End: vals[1], Target: vals[2], Depth: vals[3]})
+	}
+	if f != a.main && !f.hasIdx {
+		a.line = f.line
+		return a.errorf("function %s has no constant index", f.fn.Name)
+	}
+	a.current = nil
+	return nil
+}
+
+// finish checks the block structure once the whole listing is read.
+func (a *assembler) finish() error {
+	if err := a.finishFunction(); err != nil {
+		return err
+	}
+	if a.main == nil {
+		return fmt.Errorf("missing %s block", mainName)
+	}
+	for idx, fn := range a.functions {
+		if idx >= len(a.constants) || !a.seen[idx] {
+			return fmt.Errorf("function %s: constant %d is not in the constants section", fn.Name, idx)
+		}
+		declared, ok := a.constants[idx].(*types.CompiledFunction)
+		if !ok || declared.Name != fn.Name {
+			return fmt.Errorf("function %s: constant %d is not function %s", fn.Name, idx, fn.Name)
+		}
+		a.constants[idx] = fn
+	}
+	for idx, c := range a.constants {
+		if !a.seen[idx] {
+			return fmt.Errorf("constant %d is missing", idx)
+		}
+		if fn, ok := c.(*types.CompiledFunction); ok && a.functions[idx] != fn {
+			return fmt.Errorf("function %s (constant %d) has no block", fn.Name, idx)
+		}
+	}
+	return nil
+}
+
+func (a *assembler) bytecode() *Bytecode {
+	main := a.main
+	constants := a.constants
+	if constants == nil {
+		constants = []types.Value{}
+	}
+	return &Bytecode{
+		Instructions: main.fn.Instructions,
+		Constants:    constants,
+		NumLocals:    main.fn.NumLocals,
+		NumGlobals:   len(main.globals),
+		GlobalNames:  main.globals,
+		File:         main.fn.File,
+		Lines:        main.fn.Lines,
+		Handlers:     main.fn.Handlers,
+	}
+}
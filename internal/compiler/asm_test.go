@@ -0,0 +1,153 @@
+package compiler
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/SethGK/Inscript/internal/ast"
+)
+
+// programs exercise most of what the compiler emits: closures with defaults
+// and keywords, try/catch/finally, loops with break and continue, literals
+// and destructuring.
+var programs = map[string]string{
+	"functions": `
+function f(a, b = 2, ...rest) { return a + b + len(rest) }
+print(f(1), f(1, b = 5), f(1, 2, 3, 4))
+g = (x) -> x * 2
+print(g(21))
+`,
+	"try": `
+try { throw "x" } catch e { print(e) } finally { print("done") }
+function h() { try { return 1 } finally { print("cleanup") } }
+print(h())
+`,
+	"loops": `
+s = 0
+for i in range(10) { if i == 3 { continue } if i == 8 { break } s += i }
+n = 0
+while true { n = n + 1 if n == 3 { continue } if n == 5 { break } }
+print(s, n)
+`,
+	"literals": `
+x = [1, 2.5, "s", nil, true]
+t = {a = 1, b = {c = "d"}}
+p = (1, "two")
+a, (b, c), ...rest = [1, [2, 3], 4, 5]
+for k, v in t.items() { print(k, v) }
+`,
+}
+
+func compile(t *testing.T, name, src string, peephole bool) *Bytecode {
+	t.Helper()
+	program, err := ast.ParseFile(name+".ins", src)
+	if err != nil {
+		t.Fatalf("parse %s: %v", name, err)
+	}
+	c := New()
+	c.Peephole = peephole
+	bytecode, err := c.Compile(program)
+	if err != nil {
+		t.Fatalf("compile %s: %v", name, err)
+	}
+	return bytecode
+}
+
+func disassemble(t *testing.T, b *Bytecode) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := Disassemble(&buf, b); err != nil {
+		t.Fatalf("disassemble: %v", err)
+	}
+	return buf.String()
+}
+
+func TestAssembleRoundTrip(t *testing.T) {
+	for name, src := range programs {
+		for _, peephole := range []bool{false, true} {
+			original := compile(t, name, src, peephole)
+			listing := disassemble(t, original)
+
+			assembled, err := Assemble(listing)
+			if err != nil {
+				t.Fatalf("%s: assemble: %v\n%s", name, err, listing)
+			}
+			if !bytes.Equal(assembled.Instructions, original.Instructions) {
+				t.Errorf("%s: instructions differ after assembling the listing", name)
+			}
+			if again := disassemble(t, assembled); again != listing {
+				t.Errorf("%s: listing changed on round trip:\n--- before\n%s\n--- after\n%s", name, listing, again)
+			}
+			if err := Verify(assembled); err != nil {
+				t.Errorf("%s: assembled bytecode fails verification: %v", name, err)
+			}
+		}
+	}
+}
+
+func TestAssembleLabels(t *testing.T) {
+	bytecode, err := Assemble(`
+constants:
+  0  int 3
+  1  int 1
+
+function <module>
+  globals: n
+  OpConstant 0
+  OpSetGlobal 0
+loop:
+  OpGetGlobal 0
+  OpJumpNotTruthy done
+  OpPop
+  OpGetGlobal 0
+  OpConstant 1
+  OpSub
+  OpSetGlobal 0
+  OpJump loop
+done:
+  OpPop
+  OpNull
+  OpReturn
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Verify(bytecode); err != nil {
+		t.Fatal(err)
+	}
+	want := Instructions{}
+	for _, ins := range [][]byte{
+		Make(OpConstant, 0), Make(OpSetGlobal, 0),
+		Make(OpGetGlobal, 0), Make(OpJumpNotTruthy, 14), Make(OpPop),
+		Make(OpGetGlobal, 0), Make(OpConstant, 1), Make(OpSub), Make(OpSetGlobal, 0),
+		Make(OpJump, -20),
+		Make(OpPop), Make(OpNull), Make(OpReturn),
+	} {
+		want = append(want, ins...)
+	}
+	if !bytes.Equal(bytecode.Instructions, want) {
+		t.Errorf("got\n%s\nwant\n%s", bytecode.Instructions, want)
+	}
+}
+
+func TestAssembleErrors(t *testing.T) {
+	tests := []struct {
+		src, err string
+	}{
+		{"OpNull", "line 1: instruction outside of a function block"},
+		{"function <module>\n  OpNope", "line 2: unknown opcode"},
+		{"function <module>\n  OpConstant", "line 2: OpConstant takes 1 operand"},
+		{"function <module>\n  OpArray -1", "out of range"},
+		{"function <module>\n  OpJump nowhere", "nowhere"},
+		{"constants:\n  0  int 1\n  0  int 2", "line 3: constant 0 defined twice"},
+		{"constants:\n  0  blob 1", `unknown constant type "blob"`},
+		{"function <module>\nfunction <module>", "second <module> block"},
+	}
+	for _, tt := range tests {
+		_, err := Assemble(tt.src)
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Assemble(%q) = %v, want an error containing %q", tt.src, err, tt.err)
+		}
+	}
+}
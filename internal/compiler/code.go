@@ -73,6 +73,27 @@ const (
 	OpSetIndex
 	OpTable
 	OpImport
+	OpGetBuiltin
+	OpThrow
+	OpCallKw
+	OpJumpIfBound
+
+	// Superinstructions produced by the peephole optimizer
+	OpPopJumpIfFalse
+	OpEqualJump
+	OpNotEqualJump
+	OpLessThanJump
+	OpLessEqualJump
+	OpGreaterThanJump
+	OpGreaterEqualJump
+	OpAddLocalConst
+	OpAddGlobalConst
+
+	OpGetAttr
+	OpDupTwo
+	OpTuple
+	OpUnpack
+	OpStoreIndex
 )
 
 // Instruction widths by opcode: number and byte-width of each operand.
@@ -127,6 +148,39 @@ var operandWidths = map[Opcode][]int{
 	OpSetIndex:     {},     // no operands (pops aggregate, index, value)
 	OpTable:        {2},    // number of key-value pairs (uint16)
 	OpImport:       {2},    // string constant index for path (uint16)
+	OpGetBuiltin:   {1},    // index into types.Builtins (uint8)
+	OpThrow:        {},     // no operands (pops the thrown value)
+	OpCallKw:       {1, 1}, // positional argument count, keyword argument count
+	OpJumpIfBound:  {2, 1}, // jump offset, parameter slot
+
+	// Superinstructions
+	OpPopJumpIfFalse:   {2},    // jump offset (pops the condition)
+	OpEqualJump:        {2},    // jump offset if the comparison is false (pops both operands)
+	OpNotEqualJump:     {2},    // jump offset if the comparison is false
+	OpLessThanJump:     {2},    // jump offset if the comparison is false
+	OpLessEqualJump:    {2},    // jump offset if the comparison is false
+	OpGreaterThanJump:  {2},    // jump offset if the comparison is false
+	OpGreaterEqualJump: {2},    // jump offset if the comparison is false
+	OpAddLocalConst:    {1, 2}, // local slot, constant pool index
+	OpAddGlobalConst:   {2, 2}, // global index, constant pool index
+
+	OpGetAttr:    {2},    // string constant index of the attribute name (pops the object)
+	OpDupTwo:     {},     // pushes copies of the top two values, for compound assignment
+	OpTuple:      {2},    // number of elements
+	OpUnpack:     {2, 2}, // number of targets, index of the rest target (the number of targets if none)
+	OpStoreIndex: {},     // like OpSetIndex, but the value is beneath the aggregate and index and nothing is pushed
+}
+
+// IsJump reports whether op transfers control. The first operand of a jump is
+// its offset, relative to the end of the jump instruction.
+func IsJump(op Opcode) bool {
+	switch op {
+	case OpJump, OpJumpNotTruthy, OpJumpTruthy, OpIterNext, OpJumpIfBound,
+		OpPopJumpIfFalse, OpEqualJump, OpNotEqualJump, OpLessThanJump,
+		OpLessEqualJump, OpGreaterThanJump, OpGreaterEqualJump:
+		return true
+	}
+	return false
 }
 
 // Instructions is a slice of bytecode instructions.
@@ -212,6 +266,21 @@ func ReadOpcode(ins Instructions, offset int) Opcode {
 	return Opcode(ins[offset])
 }
 
+// InstructionStart returns the offset of the instruction that contains the byte
+// at offset, which may be one of its operands.
+func (ins Instructions) InstructionStart(offset int) int {
+	start := 0
+	for i := 0; i < len(ins) && i <= offset; {
+		start = i
+		width := 1
+		for _, w := range operandWidths[Opcode(ins[i])] {
+			width += w
+		}
+		i += width
+	}
+	return start
+}
+
 // String returns a human-readable representation of instructions for debugging.
 func (ins Instructions) String() string {
 	var out strings.Builder
@@ -235,6 +304,9 @@ func (ins Instructions) String() string {
 		for _, operand := range operands {
 			fmt.Fprintf(&out, " %d", operand)
 		}
+		if IsJump(op) {
+			fmt.Fprintf(&out, " (-> %04d)", i+1+bytesRead+operands[0])
+		}
 		fmt.Fprintln(&out)
 
 		i += 1 + bytesRead
@@ -339,6 +411,42 @@ func (op Opcode) String() string {
 		return "OpTable"
 	case OpImport:
 		return "OpImport"
+	case OpGetBuiltin:
+		return "OpGetBuiltin"
+	case OpThrow:
+		return "OpThrow"
+	case OpCallKw:
+		return "OpCallKw"
+	case OpJumpIfBound:
+		return "OpJumpIfBound"
+	case OpPopJumpIfFalse:
+		return "OpPopJumpIfFalse"
+	case OpEqualJump:
+		return "OpEqualJump"
+	case OpNotEqualJump:
+		return "OpNotEqualJump"
+	case OpLessThanJump:
+		return "OpLessThanJump"
+	case OpLessEqualJump:
+		return "OpLessEqualJump"
+	case OpGreaterThanJump:
+		return "OpGreaterThanJump"
+	case OpGreaterEqualJump:
+		return "OpGreaterEqualJump"
+	case OpAddLocalConst:
+		return "OpAddLocalConst"
+	case OpAddGlobalConst:
+		return "OpAddGlobalConst"
+	case OpGetAttr:
+		return "OpGetAttr"
+	case OpDupTwo:
+		return "OpDupTwo"
+	case OpTuple:
+		return "OpTuple"
+	case OpUnpack:
+		return "OpUnpack"
+	case OpStoreIndex:
+		return "OpStoreIndex"
 	default:
 		return fmt.Sprintf("Opcode(%d)", op)
 	}
@@ -351,4 +459,8 @@ type Bytecode struct {
 	NumLocals     int
 	NumParameters int
 	NumGlobals    int
+	GlobalNames   []string          // Global variable names, indexed by global slot
+	File          string            // Source file of the main program
+	Lines         []types.LineEntry // Source positions of the main program's instructions
+	Handlers      []types.Handler   // Error handlers of the main program's try statements
 }
@@ -2,7 +2,12 @@
 package compiler
 
 import (
+	"errors"
 	"fmt"
+	"go/token"
+	"math"
+	"path/filepath"
+	"strings"
 
 	"github.com/SethGK/Inscript/internal/ast"
 	"github.com/SethGK/Inscript/internal/types"
@@ -12,33 +17,70 @@ import (
 type Compiler struct {
 	instructions Instructions
 	constants    []types.Value
+	interned     map[interface{}]int // Constant pool index of each int, float and string constant
 
 	globals      *SymbolTable
 	symbolStack  []*SymbolTable
 	currentScope *SymbolTable
 
-	returned bool
+	returned bool // The code being compiled follows a return, break or continue and cannot run
 
-	loopJumpStack [][]int // [breakJumpPos, continueJumpPos]
+	loopJumpStack []*loopBlock // Loops enclosing the code being compiled, outermost first
+
+	tries     []*tryBlock     // Try statements enclosing the code being compiled, outermost first
+	handlers  []types.Handler // Error handlers of the function being compiled, innermost first
+	iterDepth int             // Iterators of enclosing for loops held on the operand stack
+
+	file  *ast.File         // Source being compiled; nil when positions are unknown
+	pos   token.Pos         // Position of the node currently being compiled
+	lines []types.LineEntry // Line table of the instructions being emitted
+
+	// Peephole fuses common instruction sequences into superinstructions.
+	// New and NewWithState enable it.
+	Peephole bool
+}
+
+// Error is a compile error located in the source.
+type Error struct {
+	Pos     types.Position
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Pos.String() + ": " + e.Message
 }
 
 // New creates a new top-level Compiler.
 func New() *Compiler {
-	global := NewSymbolTable()
+	return NewWithState(NewGlobalSymbolTable(), make([]types.Value, 0))
+}
+
+// NewWithState creates a top-level Compiler that continues from an existing
+// global symbol table and constant pool, so globals declared earlier (by a host
+// program or a previous compilation) keep their slots.
+func NewWithState(global *SymbolTable, constants []types.Value) *Compiler {
 	c := &Compiler{
 		instructions:  make(Instructions, 0),
-		constants:     make([]types.Value, 0),
+		constants:     constants,
 		globals:       global,
 		symbolStack:   []*SymbolTable{global},
 		currentScope:  global,
 		returned:      false,
-		loopJumpStack: make([][]int, 0),
+		loopJumpStack: make([]*loopBlock, 0),
+		interned:      make(map[interface{}]int),
+		Peephole:      true,
+	}
+	for i, val := range constants {
+		if key, ok := internKey(val); ok {
+			c.interned[key] = i
+		}
 	}
 	return c
 }
 
 // Compile compiles the AST root program and returns a Bytecode struct.
 func (c *Compiler) Compile(program *ast.Program) (*Bytecode, error) {
+	c.file = program.File
 	for _, stmt := range program.Stmts {
 		if err := c.compileStatement(stmt); err != nil {
 			return nil, err
@@ -46,21 +88,29 @@ func (c *Compiler) Compile(program *ast.Program) (*Bytecode, error) {
 	}
 	c.emit(OpNull)
 	c.emit(OpReturn)
+	if c.Peephole {
+		c.instructions, c.lines, c.handlers = peephole(c.instructions, c.lines, c.handlers)
+	}
 
 	bc := &Bytecode{
 		Instructions: c.instructions,
 		Constants:    c.constants,
 		NumLocals:    0,
 		NumGlobals:   c.globals.NumGlobalsInTable(),
+		GlobalNames:  c.globals.GlobalNames(),
+		File:         c.fileName(),
+		Lines:        c.lines,
+		Handlers:     c.handlers,
 	}
 	return bc, nil
 }
 
 // compileStatement handles statements.
-func (c *Compiler) compileStatement(s ast.Statement) error {
+func (c *Compiler) compileStatement(s ast.Statement) (err error) {
 	if c.returned {
 		return nil
 	}
+	defer c.at(s.Pos(), &err)()
 	switch stmt := s.(type) {
 	case *ast.ExprStmt:
 		if err := c.compileExpression(stmt.Expr); err != nil {
@@ -84,10 +134,17 @@ func (c *Compiler) compileStatement(s ast.Statement) error {
 			if err := c.compileExpression(stmt.Expr); err != nil {
 				return err
 			}
-			c.emit(OpReturnValue)
+			if err := c.exitTries(0, func() { c.emit(OpReturnValue) }); err != nil {
+				return err
+			}
 		} else {
-			c.emit(OpNull)
-			c.emit(OpReturn)
+			err := c.exitTries(0, func() {
+				c.emit(OpNull)
+				c.emit(OpReturn)
+			})
+			if err != nil {
+				return err
+			}
 		}
 		c.returned = true
 
@@ -110,7 +167,6 @@ func (c *Compiler) compileStatement(s ast.Statement) error {
 
 		if !inFunc {
 			c.leaveScope()
-			c.returned = false
 		}
 
 	case *ast.IfStmt:
@@ -127,6 +183,13 @@ func (c *Compiler) compileStatement(s ast.Statement) error {
 		return c.compileContinue()
 	case *ast.ImportStmt:
 		return c.compileImport(stmt)
+	case *ast.TryStmt:
+		return c.compileTry(stmt)
+	case *ast.ThrowStmt:
+		if err := c.compileExpression(stmt.Expr); err != nil {
+			return err
+		}
+		c.emit(OpThrow)
 	default:
 		return fmt.Errorf("unsupported statement: %T", s)
 	}
@@ -138,13 +201,7 @@ func (c *Compiler) compileAssignment(stmt *ast.AssignStmt) error {
 	if ident, isIdent := stmt.Target.(*ast.Identifier); isIdent {
 		sym, ok := c.currentScope.Resolve(ident.Name)
 		if !ok {
-			// If not found, define it in the current scope.
-			// If currentScope is global, it's a global. Otherwise, it's local.
-			if c.currentScope == c.globals {
-				sym = c.globals.DefineGlobal(ident.Name)
-			} else {
-				sym = c.currentScope.DefineLocal(ident.Name)
-			}
+			sym = c.defineVariable(ident.Name)
 		}
 
 		if stmt.Op.Literal != "=" { // Check for compound assignment
@@ -163,19 +220,8 @@ func (c *Compiler) compileAssignment(stmt *ast.AssignStmt) error {
 				return err
 			}
 
-			switch stmt.Op.Literal {
-			case "+=":
-				c.emit(OpAdd)
-			case "-=":
-				c.emit(OpSub)
-			case "*=":
-				c.emit(OpMul)
-			case "/=":
-				c.emit(OpDiv)
-			case "^^=":
-				c.emit(OpPow)
-			default:
-				return fmt.Errorf("unsupported compound assignment operator: %s", stmt.Op.Literal)
+			if err := c.compileCompoundOp(stmt.Op.Literal); err != nil {
+				return err
 			}
 		} else { // Simple assignment
 			if err := c.compileExpression(stmt.Value); err != nil {
@@ -183,19 +229,17 @@ func (c *Compiler) compileAssignment(stmt *ast.AssignStmt) error {
 			}
 		}
 
-		switch sym.Kind {
-		case Global:
-			c.emit(OpSetGlobal, sym.Index)
-		case Local, Parameter:
-			c.emit(OpSetLocal, sym.Index)
-
-		case Free:
-			c.emit(OpSetFree, sym.Index)
+		return c.emitSet(sym, ident.Name)
+	}
 
-		default:
-			return fmt.Errorf("cannot assign to %s %s", sym.Kind, ident.Name)
+	if tuple, isTuple := stmt.Target.(*ast.TupleTarget); isTuple {
+		if stmt.Op.Literal != "=" {
+			return fmt.Errorf("%s needs a single target", stmt.Op.Literal)
 		}
-		return nil
+		if err := c.compileExpression(stmt.Value); err != nil {
+			return err
+		}
+		return c.compileUnpack(tuple, false)
 	}
 
 	if indexTarget, isIndex := stmt.Target.(*ast.IndexExpr); isIndex {
@@ -205,10 +249,11 @@ func (c *Compiler) compileAssignment(stmt *ast.AssignStmt) error {
 		if err := c.compileExpression(indexTarget.Index); err != nil {
 			return err
 		}
-		if err := c.compileExpression(stmt.Value); err != nil {
+		if err := c.compileUpdate(stmt); err != nil {
 			return err
 		}
 		c.emit(OpSetIndex)
+		c.emit(OpPop) // OpSetIndex leaves the assigned value on the stack
 		return nil
 	}
 
@@ -217,18 +262,133 @@ func (c *Compiler) compileAssignment(stmt *ast.AssignStmt) error {
 			return err
 		}
 		c.emitConstant(types.NewString(attrTarget.Attribute))
-		if err := c.compileExpression(stmt.Value); err != nil {
+		if err := c.compileUpdate(stmt); err != nil {
 			return err
 		}
 		c.emit(OpSetIndex)
+		c.emit(OpPop) // OpSetIndex leaves the assigned value on the stack
 		return nil
 	}
 
 	return fmt.Errorf("unsupported assignment target: %T", stmt.Target)
 }
 
+// emitSet pops the value on top of the stack into the variable sym.
+func (c *Compiler) emitSet(sym *Symbol, name string) error {
+	switch sym.Kind {
+	case Global:
+		c.emit(OpSetGlobal, sym.Index)
+	case Local, Parameter:
+		c.emit(OpSetLocal, sym.Index)
+
+	case Free:
+		c.emit(OpSetFree, sym.Index)
+
+	default:
+		return fmt.Errorf("cannot assign to %s %s", sym.Kind, name)
+	}
+	return nil
+}
+
+// compileUnpack pops an iterable and assigns its values to the elements of
+// target, left to right. A rest element takes the values the others leave, as
+// a list. Loop variables are always defined in the current scope, like the
+// variable of a plain for loop.
+func (c *Compiler) compileUnpack(target *ast.TupleTarget, loopVar bool) (err error) {
+	defer c.at(target.Pos(), &err)()
+	rest := len(target.Elements)
+	for i, el := range target.Elements {
+		if _, isRest := el.(*ast.RestTarget); isRest {
+			if rest != len(target.Elements) {
+				return fmt.Errorf("only one ...rest target is allowed")
+			}
+			rest = i
+		}
+	}
+	c.emit(OpUnpack, len(target.Elements), rest)
+	for _, el := range target.Elements {
+		if err := c.compileStore(el, loopVar); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compileStore pops the value on top of the stack into target.
+func (c *Compiler) compileStore(target ast.Expression, loopVar bool) (err error) {
+	defer c.at(target.Pos(), &err)()
+	var name string
+	switch t := target.(type) {
+	case *ast.Identifier:
+		name = t.Name
+	case *ast.RestTarget:
+		name = t.Name
+	case *ast.IndexExpr:
+		if err := c.compileExpression(t.Primary); err != nil {
+			return err
+		}
+		if err := c.compileExpression(t.Index); err != nil {
+			return err
+		}
+		c.emit(OpStoreIndex)
+		return nil
+	case *ast.AttrExpr:
+		if err := c.compileExpression(t.Primary); err != nil {
+			return err
+		}
+		c.emitConstant(types.NewString(t.Attribute))
+		c.emit(OpStoreIndex)
+		return nil
+	case *ast.TupleTarget:
+		return c.compileUnpack(t, loopVar)
+	default:
+		return fmt.Errorf("unsupported assignment target: %T", target)
+	}
+
+	sym, ok := c.currentScope.Resolve(name)
+	if loopVar || !ok {
+		sym = c.defineVariable(name)
+	}
+	return c.emitSet(sym, name)
+}
+
+// compileUpdate compiles the value stored by an assignment to an element,
+// with the aggregate and index already on the stack. A compound assignment
+// reads the element through copies of them, so each is evaluated once.
+func (c *Compiler) compileUpdate(stmt *ast.AssignStmt) error {
+	if stmt.Op.Literal == "=" {
+		return c.compileExpression(stmt.Value)
+	}
+	c.emit(OpDupTwo)
+	c.emit(OpIndex)
+	if err := c.compileExpression(stmt.Value); err != nil {
+		return err
+	}
+	return c.compileCompoundOp(stmt.Op.Literal)
+}
+
+// compileCompoundOp emits the binary operator of a compound assignment.
+func (c *Compiler) compileCompoundOp(op string) error {
+	switch op {
+	case "+=":
+		c.emit(OpAdd)
+	case "-=":
+		c.emit(OpSub)
+	case "*=":
+		c.emit(OpMul)
+	case "/=":
+		c.emit(OpDiv)
+	case "^^=":
+		c.emit(OpPow)
+	default:
+		return fmt.Errorf("unsupported compound assignment operator: %s", op)
+	}
+	return nil
+}
+
 // compileExpression handles expressions.
-func (c *Compiler) compileExpression(e ast.Expression) error {
+func (c *Compiler) compileExpression(e ast.Expression) (err error) {
+	defer c.at(e.Pos(), &err)()
 	switch expr := e.(type) {
 	case *ast.IntegerLiteral:
 		c.emitConstant(types.NewInteger(expr.Value))
@@ -257,12 +417,20 @@ func (c *Compiler) compileExpression(e ast.Expression) error {
 		case Free:
 			c.emit(OpGetFree, sym.Index)
 		case Builtin:
-			c.emit(OpGetGlobal, sym.Index)
+			c.emit(OpGetBuiltin, sym.Index)
 		}
 
 	case *ast.BinaryExpr:
+		if val, ok := constantValue(expr); ok {
+			c.emitValue(val)
+			return nil
+		}
 		return c.compileBinaryExpression(expr)
 	case *ast.UnaryExpr:
+		if val, ok := constantValue(expr); ok {
+			c.emitValue(val)
+			return nil
+		}
 		return c.compileUnaryExpression(expr)
 	case *ast.CallExpr:
 		return c.compileCallExpression(expr)
@@ -276,6 +444,8 @@ func (c *Compiler) compileExpression(e ast.Expression) error {
 		return c.compileTableLiteral(expr)
 	case *ast.TupleLiteral:
 		return c.compileTupleLiteral(expr) // Call a separate function for tuple
+	case *ast.FunctionLiteral:
+		return c.compileFunction(lambdaName, expr.Params, expr.ReturnType, expr.Body)
 	default:
 		return fmt.Errorf("unsupported expression: %T", e)
 	}
@@ -284,6 +454,14 @@ func (c *Compiler) compileExpression(e ast.Expression) error {
 
 // compileBinaryExpression handles binary operators.
 func (c *Compiler) compileBinaryExpression(expr *ast.BinaryExpr) error {
+	// A constant left operand decides at compile time whether the right one runs.
+	if left, ok := constantValue(expr.Left); ok && (expr.Operator.Literal == "and" || expr.Operator.Literal == "or") {
+		if truthy(left) == (expr.Operator.Literal == "or") {
+			c.emitValue(left)
+			return nil
+		}
+		return c.compileExpression(expr.Right)
+	}
 	if expr.Operator.Literal == "and" {
 		if err := c.compileExpression(expr.Left); err != nil {
 			return err
@@ -316,46 +494,11 @@ func (c *Compiler) compileBinaryExpression(expr *ast.BinaryExpr) error {
 		return err
 	}
 
-	switch expr.Operator.Literal {
-	case "+":
-		c.emit(OpAdd)
-	case "-":
-		c.emit(OpSub)
-	case "*":
-		c.emit(OpMul)
-	case "/":
-		c.emit(OpDiv)
-	case "%":
-		c.emit(OpMod)
-	case "^^":
-		c.emit(OpPow)
-	case "//":
-		c.emit(OpIDiv)
-	case "&":
-		c.emit(OpBitAnd)
-	case "|":
-		c.emit(OpBitOr)
-	case "^":
-		c.emit(OpBitXor)
-	case "<<":
-		c.emit(OpShl)
-	case ">>":
-		c.emit(OpShr)
-	case "==":
-		c.emit(OpEqual)
-	case "!=":
-		c.emit(OpNotEqual)
-	case "<":
-		c.emit(OpLessThan)
-	case "<=":
-		c.emit(OpLessEqual)
-	case ">":
-		c.emit(OpGreaterThan)
-	case ">=":
-		c.emit(OpGreaterEqual)
-	default:
+	op, ok := binaryOps[expr.Operator.Literal]
+	if !ok {
 		return fmt.Errorf("unsupported binary operator: %s", expr.Operator.Literal)
 	}
+	c.emit(op)
 	return nil
 }
 
@@ -377,17 +520,42 @@ func (c *Compiler) compileUnaryExpression(expr *ast.UnaryExpr) error {
 	return nil
 }
 
-// compileCallExpression handles function calls.
+// compileCallExpression handles function calls. Positional arguments are
+// pushed first, followed by a name and a value for each keyword argument.
 func (c *Compiler) compileCallExpression(expr *ast.CallExpr) error {
 	if err := c.compileExpression(expr.Callee); err != nil {
 		return err
 	}
+	var keywords []*ast.KeywordArg
 	for _, arg := range expr.Args {
+		if kw, ok := arg.(*ast.KeywordArg); ok {
+			for _, prev := range keywords {
+				if prev.Name == kw.Name {
+					return c.errorAt(kw.Pos(), "keyword argument '%s' repeated", kw.Name)
+				}
+			}
+			keywords = append(keywords, kw)
+			continue
+		}
+		if len(keywords) > 0 {
+			return c.errorAt(arg.Pos(), "positional argument follows keyword argument")
+		}
 		if err := c.compileExpression(arg); err != nil {
 			return err
 		}
 	}
-	c.emit(OpCall, len(expr.Args))
+	numPositional := len(expr.Args) - len(keywords)
+	if len(keywords) == 0 {
+		c.emit(OpCall, numPositional)
+		return nil
+	}
+	for _, kw := range keywords {
+		c.emitConstant(types.NewString(kw.Name))
+		if err := c.compileExpression(kw.Value); err != nil {
+			return err
+		}
+	}
+	c.emit(OpCallKw, numPositional, len(keywords))
 	return nil
 }
 
@@ -403,13 +571,13 @@ func (c *Compiler) compileIndexExpression(expr *ast.IndexExpr) error {
 	return nil
 }
 
-// compileAttrExpression handles table attribute access.
+// compileAttrExpression handles attribute access: a table's field, or a
+// method of a built-in value, looked up by OpGetAttr.
 func (c *Compiler) compileAttrExpression(expr *ast.AttrExpr) error {
 	if err := c.compileExpression(expr.Primary); err != nil {
 		return err
 	}
-	c.emitConstant(types.NewString(expr.Attribute))
-	c.emit(OpIndex)
+	c.emit(OpGetAttr, c.addConstant(types.NewString(expr.Attribute)))
 	return nil
 }
 
@@ -436,8 +604,8 @@ func (c *Compiler) compileTableLiteral(expr *ast.TableLiteral) error {
 		case *ast.StringLiteral: // Explicitly handle string literals as keys
 			c.emitConstant(types.NewString(key.Value))
 		default:
-			// For any other expression type (e.g., `{"key" + "name" = 10}`),
-			// compile the expression and expect it to resolve to a string at runtime.
+			// For any other expression type (e.g., `{1 + 2 = 10}`), compile the
+			// expression; OpTable checks at runtime that its value is hashable.
 			if err := c.compileExpression(field.Key); err != nil {
 				return err
 			}
@@ -459,28 +627,104 @@ func (c *Compiler) compileTupleLiteral(expr *ast.TupleLiteral) error {
 			return err
 		}
 	}
-	c.emit(OpArray, len(expr.Elements)) // Tuples can be represented as immutable arrays
+	c.emit(OpTuple, len(expr.Elements))
 	return nil
 }
 
+// lambdaName is the name function literals have in tracebacks.
+const lambdaName = "<lambda>"
+
 // compileFuncDef compiles a function definition.
 func (c *Compiler) compileFuncDef(stmt *ast.FunctionDef) error {
-	// 1. Save the current instructions slice for the outer scope
-	outerInstructions := c.instructions
+	if err := c.compileFunction(stmt.Name, stmt.Params, stmt.ReturnType, stmt.Body); err != nil {
+		return err
+	}
+
+	// Define the function name in the current (outer) scope
+	funcSym, ok := c.currentScope.Resolve(stmt.Name)
+	if !ok {
+		funcSym = c.defineVariable(stmt.Name)
+	}
+
+	switch funcSym.Kind {
+	case Global:
+		c.emit(OpSetGlobal, funcSym.Index)
+	case Local, Parameter:
+		c.emit(OpSetLocal, funcSym.Index)
+	case Free:
+		c.emit(OpSetFree, funcSym.Index)
+	default:
+		return fmt.Errorf("cannot assign function to %s %s", funcSym.Kind, stmt.Name)
+	}
+	return nil
+}
+
+// compileFunction compiles a function body and emits the instructions that
+// create its closure, leaving the closure on the stack. It is shared by
+// function definitions and function literals.
+func (c *Compiler) compileFunction(name string, params []ast.Param, result *ast.TypeAnnotation, body *ast.BlockStmt) error {
+	if err := c.checkParams(params); err != nil {
+		return err
+	}
+	if err := c.checkAnnotation(result); err != nil {
+		return err
+	}
+	returnType := ""
+	if result != nil {
+		returnType = result.Name
+	}
+
+	// 1. Save the current instructions slice (and its line table and handlers) for the outer scope
+	outerInstructions, outerLines := c.instructions, c.lines
+	outerTries, outerHandlers, outerIterDepth, outerLoops := c.tries, c.handlers, c.iterDepth, c.loopJumpStack
 	c.instructions = make(Instructions, 0) // Initialize a NEW slice for this function's instructions
+	c.lines = nil
+	c.tries, c.handlers, c.iterDepth, c.loopJumpStack = nil, nil, 0, nil
 
 	// 2. Create the function's scope and set it as current.
 	c.enterScope(true)          // This creates the function's scope and sets c.currentScope to it.
 	funcScope := c.currentScope // Now funcScope truly points to the function's symbol table.
 
-	for _, param := range stmt.Params {
+	paramNames := make([]string, len(params))
+	var paramTypes []string
+	numDefaults := 0
+	for i, param := range params {
 		funcScope.DefineParameter(param.Name) // Define parameters directly in funcScope
+		paramNames[i] = param.Name
+		if param.DefaultValue != nil {
+			numDefaults++
+		}
+		if param.Type != nil {
+			if paramTypes == nil {
+				paramTypes = make([]string, len(params))
+			}
+			paramTypes[i] = param.Type.Name
+		}
+	}
+	variadic := len(params) > 0 && params[len(params)-1].IsVariadic
+
+	// Defaults are evaluated on each call that leaves their parameter unbound,
+	// in the function's scope, so they can refer to earlier parameters.
+	for i, param := range params {
+		if param.DefaultValue == nil {
+			continue
+		}
+		skipPos := c.emit(OpJumpIfBound, 0, i)
+		if err := c.compileExpression(param.DefaultValue); err != nil {
+			c.leaveScope()
+			c.instructions, c.lines = outerInstructions, outerLines
+			c.tries, c.handlers, c.iterDepth, c.loopJumpStack = outerTries, outerHandlers, outerIterDepth, outerLoops
+			return err
+		}
+		c.emit(OpSetLocal, i)
+		c.patchJump(skipPos, len(c.instructions))
 	}
 
 	// 3. Compile the function body using the new (function-specific) instructions slice.
-	if err := c.compileStatement(stmt.Body); err != nil {
+	if err := c.compileStatement(body); err != nil {
 		// IMPORTANT: If there's an error, you must restore instructions before returning
-		c.instructions = outerInstructions
+		c.instructions, c.lines = outerInstructions, outerLines
+		c.tries, c.handlers, c.iterDepth, c.loopJumpStack = outerTries, outerHandlers, outerIterDepth, outerLoops
 		c.leaveScope()
 		return err
 	}
@@ -491,23 +735,43 @@ func (c *Compiler) compileFuncDef(stmt *ast.FunctionDef) error {
 	}
 
 	// 4. Capture the compiled instructions for this function.
-	functionInstructions := c.instructions
+	functionInstructions, functionLines, functionHandlers := c.instructions, c.lines, c.handlers
+	if c.Peephole {
+		functionInstructions, functionLines, functionHandlers = peephole(functionInstructions, functionLines, functionHandlers)
+	}
 
 	// Get numDefinitions from funcScope, which correctly accumulated parameters and direct locals.
 	functionNumLocals := funcScope.NumDefinitions()
-	functionNumParameters := len(stmt.Params)
+	functionNumParameters := len(params)
 
 	freeSymbols := funcScope.FreeSymbols() // Get free symbols from the function's scope
+	localNames := funcScope.LocalNames()
+	freeNames := make([]string, len(freeSymbols))
+	for i, sym := range freeSymbols {
+		freeNames[i] = sym.Name
+	}
 
 	// 5. Restore the outer scope and its instructions.
 	c.leaveScope()
-	c.instructions = outerInstructions // Restore the instructions slice for the outer scope
+	c.instructions, c.lines = outerInstructions, outerLines // Restore the instructions slice for the outer scope
+	c.tries, c.handlers, c.iterDepth, c.loopJumpStack = outerTries, outerHandlers, outerIterDepth, outerLoops
 
 	compiledFn := &types.CompiledFunction{
+		Name:          name,
 		Instructions:  functionInstructions, // This is the function's bytecode
 		NumLocals:     functionNumLocals,
 		NumParameters: functionNumParameters,
+		ParamNames:    paramNames,
+		NumDefaults:   numDefaults,
+		Variadic:      variadic,
+		ParamTypes:    paramTypes,
+		ReturnType:    returnType,
 		FreeCount:     len(freeSymbols),
+		LocalNames:    localNames,
+		FreeNames:     freeNames,
+		File:          c.fileName(),
+		Lines:         functionLines,
+		Handlers:      functionHandlers,
 	}
 
 	fnConstIndex := len(c.constants)
@@ -529,7 +793,7 @@ func (c *Compiler) compileFuncDef(stmt *ast.FunctionDef) error {
 		case Free:
 			c.emit(OpGetFree, outerSym.Index) // Use outerSym.Index for nested free variables
 		case Builtin:
-			c.emit(OpGetGlobal, outerSym.Index)
+			c.emit(OpGetBuiltin, outerSym.Index)
 		default:
 			return fmt.Errorf("unsupported free variable kind for closure capture: %s for '%s'", outerSym.Kind, sym.Name)
 		}
@@ -537,42 +801,32 @@ func (c *Compiler) compileFuncDef(stmt *ast.FunctionDef) error {
 
 	c.emit(OpClosure, fnConstIndex, len(freeSymbols))
 
-	// Define the function name in the current (outer) scope
-	funcSym, ok := c.currentScope.Resolve(stmt.Name)
-	if !ok {
-		if c.currentScope == c.globals {
-			funcSym = c.globals.DefineGlobal(stmt.Name)
-		} else {
-			funcSym = c.currentScope.DefineLocal(stmt.Name)
-		}
-	}
-
-	switch funcSym.Kind {
-	case Global:
-		c.emit(OpSetGlobal, funcSym.Index)
-	case Local, Parameter:
-		c.emit(OpSetLocal, funcSym.Index)
-	case Free:
-		c.emit(OpSetFree, funcSym.Index)
-	default:
-		return fmt.Errorf("cannot assign function to %s %s", funcSym.Kind, stmt.Name)
-	}
-
-	c.returned = false
+	c.returned = false // A return in the body does not end the enclosing code
 
 	return nil
 }
 
+// loopBlock is a loop whose body is being compiled.
+type loopBlock struct {
+	start  int   // Offset continue statements jump to
+	breaks []int // Break jumps, patched to the end of the loop
+	tries  int   // Number of try statements enclosing the loop
+	iter   bool  // Whether the loop holds an iterator on the stack
+}
+
 // compileBreak compiles a break statement.
 func (c *Compiler) compileBreak() error {
 	if len(c.loopJumpStack) == 0 {
 		return fmt.Errorf("break statement outside of a loop")
 	}
-	loopJumps := c.loopJumpStack[len(c.loopJumpStack)-1]
-	breakJumpPos := loopJumps[0]
-
-	c.emit(OpJump, breakJumpPos)
-	return nil
+	loop := c.loopJumpStack[len(c.loopJumpStack)-1]
+	return c.exitTries(loop.tries, func() {
+		if loop.iter {
+			c.emit(OpPop) // The loop's exit pops the iterator, so break does too
+		}
+		loop.breaks = append(loop.breaks, c.emit(OpJump, 0))
+		c.returned = true
+	})
 }
 
 // compileContinue compiles a continue statement.
@@ -580,39 +834,223 @@ func (c *Compiler) compileContinue() error {
 	if len(c.loopJumpStack) == 0 {
 		return fmt.Errorf("continue statement outside of a loop")
 	}
-	loopJumps := c.loopJumpStack[len(c.loopJumpStack)-1]
-	continueJumpPos := loopJumps[1]
-
-	c.emit(OpJump, continueJumpPos)
-	return nil
+	loop := c.loopJumpStack[len(c.loopJumpStack)-1]
+	return c.exitTries(loop.tries, func() {
+		c.patchJump(c.emit(OpJump, 0), loop.start)
+		c.returned = true
+	})
 }
 
 // compileImport handles import statements.
+// The module is bound to a variable named after the file, so `import "lib/math.ins"`
+// makes the module's globals available as `math.name`.
 func (c *Compiler) compileImport(stmt *ast.ImportStmt) error {
-	c.emitConstant(types.NewString(stmt.Path))
-	c.emit(OpImport, 0)
-	c.emit(OpPop)
+	name := moduleName(stmt.Path)
+	if name == "" {
+		return fmt.Errorf("invalid import path %q", stmt.Path)
+	}
+
+	pathIndex := len(c.constants)
+	c.constants = append(c.constants, types.NewString(stmt.Path))
+	c.emit(OpImport, pathIndex)
+
+	sym, ok := c.currentScope.Resolve(name)
+	if !ok {
+		sym = c.defineVariable(name)
+	}
+
+	switch sym.Kind {
+	case Global:
+		c.emit(OpSetGlobal, sym.Index)
+	case Local, Parameter:
+		c.emit(OpSetLocal, sym.Index)
+	case Free:
+		c.emit(OpSetFree, sym.Index)
+	default:
+		return fmt.Errorf("cannot bind module to %s %s", sym.Kind, name)
+	}
 	return nil
 }
 
+// moduleName derives the binding name of an imported module from its path.
+func moduleName(path string) string {
+	base := filepath.Base(path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+// defineVariable defines a new variable in the innermost scope that owns storage.
+// Outside of any function every variable is a module global, including those first
+// assigned inside top-level blocks and loops; the main program has no local slots.
+func (c *Compiler) defineVariable(name string) *Symbol {
+	for scope := c.currentScope; scope != nil; scope = scope.Outer {
+		if scope.isFunctionScope {
+			return c.currentScope.DefineLocal(name)
+		}
+	}
+	return c.globals.DefineGlobal(name)
+}
+
 // emit appends an instruction.
 func (c *Compiler) emit(op Opcode, operands ...int) int {
 	ins := Make(op, operands...)
 	pos := len(c.instructions)
 	c.instructions = append(c.instructions, ins...)
+	c.addLine(pos)
 	return pos
 }
 
+// addLine records the current source position for the instruction at offset,
+// unless it is the same as the position of the previous instruction.
+func (c *Compiler) addLine(offset int) {
+	if c.file == nil {
+		return
+	}
+	p := c.file.Position(c.pos)
+	if n := len(c.lines); n > 0 && c.lines[n-1].Line == p.Line && c.lines[n-1].Column == p.Column {
+		return
+	}
+	c.lines = append(c.lines, types.LineEntry{Offset: offset, Line: p.Line, Column: p.Column})
+}
+
+// at makes pos the current source position until the returned function is
+// called, which also attaches pos to *errp if the error has no position yet.
+// Use it as `defer c.at(node.Pos(), &err)()`.
+func (c *Compiler) at(pos token.Pos, errp *error) func() {
+	prev := c.pos
+	c.pos = pos
+	return func() {
+		c.pos = prev
+		var located *Error
+		if *errp != nil && !errors.As(*errp, &located) {
+			*errp = &Error{Pos: c.position(pos), Message: (*errp).Error()}
+		}
+	}
+}
+
+// position resolves a node position in the file being compiled.
+func (c *Compiler) position(pos token.Pos) types.Position {
+	if c.file == nil {
+		return types.Position{}
+	}
+	p := c.file.Position(pos)
+	return types.Position{File: p.Filename, Line: p.Line, Column: p.Column}
+}
+
+// fileName returns the name of the file being compiled, if known.
+func (c *Compiler) fileName() string {
+	if c.file == nil {
+		return ""
+	}
+	return c.file.Name
+}
+
+// checkParams validates a parameter list: names are unique, parameters
+// without defaults never follow ones with defaults, and a variadic parameter
+// comes last.
+func (c *Compiler) checkParams(params []ast.Param) error {
+	seen := make(map[string]bool, len(params))
+	sawDefault := false
+	for i, param := range params {
+		if seen[param.Name] {
+			return c.errorAt(param.PosToken, "duplicate parameter '%s'", param.Name)
+		}
+		seen[param.Name] = true
+		if err := c.checkAnnotation(param.Type); err != nil {
+			return err
+		}
+		switch {
+		case param.IsVariadic:
+			if i != len(params)-1 {
+				return c.errorAt(param.PosToken, "variadic parameter '%s' must be last", param.Name)
+			}
+		case param.DefaultValue != nil:
+			sawDefault = true
+		case sawDefault:
+			return c.errorAt(param.PosToken, "non-default parameter '%s' follows default parameter", param.Name)
+		}
+	}
+	return nil
+}
+
+// checkAnnotation rejects type annotations the VM cannot check.
+func (c *Compiler) checkAnnotation(a *ast.TypeAnnotation) error {
+	if a == nil {
+		return nil
+	}
+	for _, name := range types.AnnotationTypes {
+		if a.Name == name {
+			return nil
+		}
+	}
+	return c.errorAt(a.PosToken, "unknown type '%s'", a.Name)
+}
+
+// errorAt returns a compile error located at pos.
+func (c *Compiler) errorAt(pos token.Pos, format string, a ...interface{}) error {
+	return &Error{Pos: c.position(pos), Message: fmt.Sprintf(format, a...)}
+}
+
 // emitConstant adds a constant and emits OpConstant.
 func (c *Compiler) emitConstant(val types.Value) {
+	c.emit(OpConstant, c.addConstant(val))
+}
+
+// addConstant adds a constant to the pool and returns its index. Equal ints,
+// floats and strings share one constant pool entry.
+func (c *Compiler) addConstant(val types.Value) int {
+	key, intern := internKey(val)
+	if idx, ok := c.interned[key]; intern && ok {
+		return idx
+	}
 	idx := len(c.constants)
 	c.constants = append(c.constants, val)
-	c.emit(OpConstant, idx)
+	if intern {
+		c.interned[key] = idx
+	}
+	return idx
 }
 
-// patchJump fixes a jump operand.
+// floatBits keys float constants by their bits, so that 0.0 and -0.0 stay
+// apart and NaN matches itself.
+type floatBits uint64
+
+// internKey returns the key equal constants share, if val can be shared.
+func internKey(val types.Value) (interface{}, bool) {
+	switch val := val.(type) {
+	case *types.Integer:
+		return val.Value, true
+	case *types.Float:
+		return floatBits(math.Float64bits(val.Value)), true
+	case *types.String:
+		return val.Value, true
+	}
+	return nil, false
+}
+
+// emitValue emits the instruction that pushes a constant value.
+func (c *Compiler) emitValue(val types.Value) {
+	switch val := val.(type) {
+	case *types.Boolean:
+		if val.Value {
+			c.emit(OpTrue)
+		} else {
+			c.emit(OpFalse)
+		}
+	case *types.Nil:
+		c.emit(OpNull)
+	default:
+		c.emitConstant(val)
+	}
+}
+
+// patchJump fixes a jump operand. The offset is the first operand and is
+// relative to the end of the jump instruction.
 func (c *Compiler) patchJump(jumpPos, target int) {
-	offset := target - (jumpPos + 3)
+	size := 1
+	for _, w := range operandWidths[Opcode(c.instructions[jumpPos])] {
+		size += w
+	}
+	offset := target - (jumpPos + size)
 	c.instructions[jumpPos+1] = byte(offset >> 8)
 	c.instructions[jumpPos+2] = byte(offset)
 }
@@ -635,58 +1073,157 @@ func (c *Compiler) leaveScope() {
 	c.currentScope = c.symbolStack[len(c.symbolStack)-1]
 }
 
-// compileIf compiles an if statement with optional else.
+// compileIf compiles an if statement with its else if branches and optional
+// else as a single ladder. Each condition stays on the stack across
+// OpJumpNotTruthy, so each branch starts by popping it, and every branch that
+// falls through jumps to the end:
+//
+//	    <cond 1>
+//	    OpJumpNotTruthy next1
+//	    OpPop
+//	    <body 1>
+//	    OpJump end
+//	next1:
+//	    OpPop
+//	    <cond 2>
+//	    ...
+//	nextN:
+//	    OpPop
+//	    <else body>
+//	end:
+//
+// A branch whose condition is constant is either dropped or becomes the else
+// of the ladder, leaving the branches after it dead.
 func (c *Compiler) compileIf(stmt *ast.IfStmt) error {
-	if err := c.compileExpression(stmt.Cond); err != nil {
-		return err
-	}
-	jumpNotTruthyPos := c.emit(OpJumpNotTruthy, 0)
-	if err := c.compileStatement(stmt.Then); err != nil {
-		return err
-	}
-	if c.returned {
-		c.patchJump(jumpNotTruthyPos, len(c.instructions))
+	branches := append([]ast.ElseIf{{Cond: stmt.Cond, Body: stmt.Then, PosToken: stmt.PosToken}}, stmt.ElseIfs...)
+	final := stmt.Else // Runs when no condition holds
+	var exits []int
+	returned := true // Whether every branch compiled so far returns
+
+	for i, branch := range branches {
+		if cond, ok := constantValue(branch.Cond); ok {
+			if !truthy(cond) {
+				if err := c.compileDead(branch.Body); err != nil {
+					return err
+				}
+				continue
+			}
+			// Later branches can never run.
+			for _, dead := range branches[i+1:] {
+				if err := c.compileDead(&ast.ExprStmt{Expr: dead.Cond, PosToken: dead.PosToken}); err != nil {
+					return err
+				}
+				if err := c.compileDead(dead.Body); err != nil {
+					return err
+				}
+			}
+			if final != nil {
+				if err := c.compileDead(final); err != nil {
+					return err
+				}
+			}
+			final = branch.Body
+			break
+		}
+
+		if err := c.compileExpression(branch.Cond); err != nil {
+			return err
+		}
+		next := c.emit(OpJumpNotTruthy, 0)
+		c.emit(OpPop)
+		if err := c.compileStatement(branch.Body); err != nil {
+			return err
+		}
+		if !c.returned {
+			exits = append(exits, c.emit(OpJump, 0))
+		}
+		returned = returned && c.returned
 		c.returned = false
-		return nil
+		c.patchJump(next, len(c.instructions))
+		c.emit(OpPop)
 	}
 
-	jumpPos := c.emit(OpJump, 0)
-	afterThenPos := len(c.instructions)
-	c.patchJump(jumpNotTruthyPos, afterThenPos)
-
-	if stmt.Else != nil {
-		if err := c.compileStatement(stmt.Else); err != nil {
+	if final != nil {
+		if err := c.compileStatement(final); err != nil {
 			return err
 		}
 	}
-	afterElsePos := len(c.instructions)
-	c.patchJump(jumpPos, afterElsePos)
+	// Code after the statement is only unreachable if every branch returns.
+	returned = returned && final != nil && c.returned
+	for _, pos := range exits {
+		c.patchJump(pos, len(c.instructions))
+	}
+	c.returned = returned
 	return nil
 }
 
-// compileWhile compiles a while loop.
-func (c *Compiler) compileWhile(stmt *ast.WhileStmt) error {
-	loopStart := len(c.instructions)
+// compileDead compiles a statement that can never run and discards its code.
+// It is still compiled so that it declares the same variables and reports the
+// same errors as live code.
+func (c *Compiler) compileDead(s ast.Statement) error {
+	instructions, lines, handlers, constants := len(c.instructions), len(c.lines), len(c.handlers), len(c.constants)
+	breaks := make([]int, len(c.loopJumpStack))
+	for i, loop := range c.loopJumpStack {
+		breaks[i] = len(loop.breaks)
+	}
+	tries := make([]tryBlock, len(c.tries))
+	for i, t := range c.tries {
+		tries[i] = *t
+	}
 
-	breakJumpPos := c.emit(OpJump, 0)
-	continueJumpPos := len(c.instructions)
+	err := c.compileStatement(s)
 
-	c.loopJumpStack = append(c.loopJumpStack, []int{breakJumpPos, continueJumpPos})
+	c.instructions, c.lines, c.handlers = c.instructions[:instructions], c.lines[:lines], c.handlers[:handlers]
+	for i, loop := range c.loopJumpStack {
+		loop.breaks = loop.breaks[:breaks[i]]
+	}
+	for i, t := range c.tries {
+		*t = tries[i]
+	}
+	for key, idx := range c.interned {
+		if idx >= constants {
+			delete(c.interned, key)
+		}
+	}
+	c.constants = c.constants[:constants]
+	c.returned = false
+	return err
+}
+
+// compileWhile compiles a while loop:
+//
+//	start:
+//	    <cond>
+//	    OpJumpNotTruthy exit
+//	    OpPop
+//	    <body>            continue jumps to start
+//	    OpJump start
+//	exit:
+//	    OpPop
+//	end:                  break jumps here
+func (c *Compiler) compileWhile(stmt *ast.WhileStmt) error {
+	loop := &loopBlock{start: len(c.instructions), tries: len(c.tries)}
+	c.loopJumpStack = append(c.loopJumpStack, loop)
 
 	if err := c.compileExpression(stmt.Cond); err != nil {
 		return err
 	}
 	exitPos := c.emit(OpJumpNotTruthy, 0)
+	c.emit(OpPop)
 
 	if err := c.compileStatement(stmt.Body); err != nil {
 		return err
 	}
+	if !c.returned {
+		c.patchJump(c.emit(OpJump, 0), loop.start)
+	}
+	c.returned = false // The loop exits when the condition fails
 
-	c.emit(OpJump, loopStart)
-
-	afterLoop := len(c.instructions)
-	c.patchJump(exitPos, afterLoop)
-	c.patchJump(breakJumpPos, afterLoop)
+	c.patchJump(exitPos, len(c.instructions))
+	c.emit(OpPop)
+	for _, pos := range loop.breaks {
+		c.patchJump(pos, len(c.instructions))
+	}
 
 	c.loopJumpStack = c.loopJumpStack[:len(c.loopJumpStack)-1]
 
@@ -702,24 +1239,168 @@ func (c *Compiler) compileFor(stmt *ast.ForStmt) error {
 	c.emit(OpGetIter)
 
 	loopStart := len(c.instructions)
+	loop := &loopBlock{start: loopStart, tries: len(c.tries), iter: true}
+	c.loopJumpStack = append(c.loopJumpStack, loop)
 
 	exitJumpPos := c.emit(OpIterNext, 0)
 
-	sym := c.currentScope.DefineLocal(stmt.Variable)
-	c.emit(OpSetLocal, sym.Index)
+	if err := c.compileStore(stmt.Target, true); err != nil {
+		return err
+	}
 
+	c.iterDepth++ // The iterator stays on the stack while the body runs
 	if err := c.compileStatement(stmt.Body); err != nil {
 		return err
 	}
+	c.iterDepth--
+	c.returned = false // The loop exits when the iterator is exhausted
 
 	backJumpPos := len(c.instructions)
 	backOffset := loopStart - (backJumpPos + 3)
-	fmt.Printf("DEBUG COMPILER: loopStart=%d, backJumpPos=%d, Calculated backOffset=%d\n",
-		loopStart, backJumpPos, backOffset)
 	c.emit(OpJump, backOffset)
 
 	afterLoop := len(c.instructions)
 	c.patchJump(exitJumpPos, afterLoop)
+	for _, pos := range loop.breaks {
+		c.patchJump(pos, afterLoop)
+	}
+
+	c.loopJumpStack = c.loopJumpStack[:len(c.loopJumpStack)-1]
+
+	return nil
+}
+
+// tryBlock is a try statement whose protected code is being compiled. Its
+// protected range is suspended while code that leaves the statement runs, so
+// a single statement can protect several ranges.
+type tryBlock struct {
+	finally *ast.BlockStmt // Block run on every exit; nil for the catch part of a statement
+	start   int            // Start of the current protected range, or -1 while suspended
+	ranges  [][2]int       // Completed protected ranges
+}
+
+// beginTry starts protecting the code compiled from here on.
+func (c *Compiler) beginTry(finally *ast.BlockStmt) *tryBlock {
+	t := &tryBlock{finally: finally, start: len(c.instructions)}
+	c.tries = append(c.tries, t)
+	return t
+}
+
+// endTry stops protecting code with the innermost try block and sends errors
+// raised in its ranges to target.
+func (c *Compiler) endTry(target int) {
+	t := c.tries[len(c.tries)-1]
+	c.tries = c.tries[:len(c.tries)-1]
+	t.suspend(len(c.instructions))
+	for _, r := range t.ranges {
+		c.handlers = append(c.handlers, types.Handler{Start: r[0], End: r[1], Target: target, Depth: c.iterDepth})
+	}
+}
+
+func (t *tryBlock) suspend(offset int) {
+	if t.start >= 0 && t.start < offset {
+		t.ranges = append(t.ranges, [2]int{t.start, offset})
+	}
+	t.start = -1
+}
+
+// exitTries compiles the finally blocks of the try statements that a return,
+// break or continue leaves, innermost first, down to the statement at level,
+// then calls leave to emit the instructions that leave them. Each finally
+// block runs outside the statement it belongs to, so errors it raises go to
+// the enclosing handlers, and the statements' protected ranges resume after
+// the exit.
+func (c *Compiler) exitTries(level int, leave func()) error {
+	tries := c.tries
+	defer func() {
+		c.tries = tries
+		for _, t := range tries[level:] {
+			t.start = len(c.instructions)
+		}
+	}()
+	for k := len(tries) - 1; k >= level; k-- {
+		tries[k].suspend(len(c.instructions))
+		if tries[k].finally == nil {
+			continue
+		}
+		c.tries = append([]*tryBlock(nil), tries[:k]...)
+		if err := c.compileStatement(tries[k].finally); err != nil {
+			return err
+		}
+	}
+	leave()
+	return nil
+}
 
+// compileTry compiles a try statement:
+//
+//	    <body>            protected by the catch and finally handlers
+//	    <finally>
+//	    OpJump end
+//	catch:                the error is on the stack
+//	    OpSet* <var>
+//	    <catch body>      protected by the finally handler
+//	    <finally>
+//	    OpJump end
+//	finally:              the error is on the stack
+//	    <finally>
+//	    OpThrow
+//	end:
+func (c *Compiler) compileTry(stmt *ast.TryStmt) error {
+	level := len(c.tries)
+	if stmt.Finally != nil {
+		c.beginTry(stmt.Finally)
+	}
+	if stmt.Catch != nil {
+		c.beginTry(nil)
+	}
+
+	var endJumps []int
+	// exit compiles the normal exit from the body or the catch block.
+	exit := func() error {
+		if c.returned {
+			c.returned = false // The block ended with a return; nothing follows it
+			return nil
+		}
+		return c.exitTries(level, func() {
+			endJumps = append(endJumps, c.emit(OpJump, 0))
+		})
+	}
+
+	if err := c.compileStatement(stmt.Body); err != nil {
+		return err
+	}
+	if err := exit(); err != nil {
+		return err
+	}
+
+	if stmt.Catch != nil {
+		c.endTry(len(c.instructions))
+		sym := c.defineVariable(stmt.CatchVar)
+		if sym.Kind == Global {
+			c.emit(OpSetGlobal, sym.Index)
+		} else {
+			c.emit(OpSetLocal, sym.Index)
+		}
+		if err := c.compileStatement(stmt.Catch); err != nil {
+			return err
+		}
+		if err := exit(); err != nil {
+			return err
+		}
+	}
+
+	if stmt.Finally != nil {
+		c.endTry(len(c.instructions))
+		if err := c.compileStatement(stmt.Finally); err != nil {
+			return err
+		}
+		c.emit(OpThrow)
+		c.returned = false
+	}
+
+	for _, pos := range endJumps {
+		c.patchJump(pos, len(c.instructions))
+	}
 	return nil
 }
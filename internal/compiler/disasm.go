@@ -0,0 +1,240 @@
+package compiler
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/SethGK/Inscript/internal/types"
+)
+
+// mainName names the main program in listings and tracebacks.
+const mainName = "<module>"
+
+// Disassemble writes a listing of the constant pool, the main program and
+// every function in the pool, in the order they are first referenced.
+// Operands are annotated with the constants, names and jump targets they refer
+// to. The listing depends only on the bytecode, so it is stable across runs,
+// and Assemble turns it back into the same bytecode.
+func Disassemble(w io.Writer, b *Bytecode) error {
+	d := &disassembler{bytecode: b, listed: make(map[int]bool)}
+	d.constants()
+	main := &types.CompiledFunction{
+		Name:         mainName,
+		Instructions: b.Instructions,
+		NumLocals:    b.NumLocals,
+		File:         b.File,
+		Lines:        b.Lines,
+		Handlers:     b.Handlers,
+	}
+	d.function(main, -1)
+	for {
+		for len(d.queue) > 0 {
+			idx := d.queue[0]
+			d.queue = d.queue[1:]
+			d.out.WriteString("\n")
+			d.function(b.Constants[idx].(*types.CompiledFunction), idx)
+		}
+		// Functions no instruction refers to are listed last.
+		for idx, c := range b.Constants {
+			if _, ok := c.(*types.CompiledFunction); ok && !d.listed[idx] {
+				d.listed[idx] = true
+				d.queue = append(d.queue, idx)
+			}
+		}
+		if len(d.queue) == 0 {
+			break
+		}
+	}
+	_, err := io.WriteString(w, d.out.String())
+	return err
+}
+
+type disassembler struct {
+	bytecode *Bytecode
+	out      strings.Builder
+	listed   map[int]bool // Constant indexes of the functions listed or queued
+	queue    []int        // Constant indexes of the functions still to list
+}
+
+// constants lists the constant pool. Functions are listed by name here and
+// in full in their own blocks.
+func (d *disassembler) constants() {
+	if len(d.bytecode.Constants) == 0 {
+		return
+	}
+	d.out.WriteString("constants:\n")
+	for i, c := range d.bytecode.Constants {
+		var text string
+		switch c := c.(type) {
+		case *types.Integer:
+			text = "int " + strconv.FormatInt(c.Value, 10)
+		case *types.Float:
+			text = "float " + strconv.FormatFloat(c.Value, 'g', -1, 64)
+		case *types.String:
+			text = "string " + strconv.Quote(c.Value)
+		case *types.CompiledFunction:
+			text = "function " + c.Name
+		default:
+			text = types.TypeName(c) + " " + c.Inspect()
+		}
+		fmt.Fprintf(&d.out, "  %d  %s\n", i, text)
+	}
+	d.out.WriteString("\n")
+}
+
+// function lists one function: a header with its signature and names, then
+// its instructions. idx is the function's constant index, or -1 for the main
+// program.
+func (d *disassembler) function(fn *types.CompiledFunction, idx int) {
+	fmt.Fprintf(&d.out, "function %s", signature(fn))
+	if fn.File != "" {
+		fmt.Fprintf(&d.out, " in %s", fn.File)
+	}
+	d.out.WriteString("\n")
+	if idx >= 0 {
+		fmt.Fprintf(&d.out, "  constant: %d\n", idx)
+	}
+	if idx < 0 && len(d.bytecode.GlobalNames) > 0 {
+		fmt.Fprintf(&d.out, "  globals: %s\n", names(d.bytecode.GlobalNames, len(d.bytecode.GlobalNames)))
+	}
+	if fn.NumLocals > 0 {
+		fmt.Fprintf(&d.out, "  locals: %s\n", names(fn.LocalNames, fn.NumLocals))
+	}
+	if fn.FreeCount > 0 {
+		fmt.Fprintf(&d.out, "  free: %s\n", names(fn.FreeNames, fn.FreeCount))
+	}
+	for _, h := range fn.Handlers {
+		fmt.Fprintf(&d.out, "  handler: %04d-%04d -> %04d, depth %d\n", h.Start, h.End, h.Target, h.Depth)
+	}
+
+	ins := Instructions(fn.Instructions)
+	line := 0
+	for i := 0; i < len(ins); {
+		op := ReadOpcode(ins, i)
+		if _, ok := operandWidths[op]; !ok {
+			fmt.Fprintf(&d.out, "%04d  %-8s ERROR: unknown opcode %d\n", i, "", op)
+			i++
+			continue
+		}
+		operands, bytesRead := ReadOperands(op, ins, i+1)
+
+		pos := ""
+		for line < len(fn.Lines) && fn.Lines[line].Offset <= i {
+			if fn.Lines[line].Offset == i {
+				pos = fmt.Sprintf("%d:%d", fn.Lines[line].Line, fn.Lines[line].Column)
+			}
+			line++
+		}
+
+		text := op.String()
+		for _, operand := range operands {
+			text += fmt.Sprintf(" %d", operand)
+		}
+		if note := d.annotate(fn, op, operands, i+1+bytesRead); note != "" {
+			fmt.Fprintf(&d.out, "%04d  %-8s %-24s ; %s\n", i, pos, text, note)
+		} else {
+			fmt.Fprintf(&d.out, "%04d  %-8s %s\n", i, pos, text)
+		}
+		i += 1 + bytesRead
+	}
+}
+
+// annotate describes what the operands of an instruction refer to. next is
+// the offset of the following instruction.
+func (d *disassembler) annotate(fn *types.CompiledFunction, op Opcode, operands []int, next int) string {
+	if IsJump(op) {
+		note := fmt.Sprintf("-> %04d", next+operands[0])
+		if op == OpJumpIfBound {
+			note += ", if " + name(fn.LocalNames, operands[1]) + " is bound"
+		}
+		return note
+	}
+	switch op {
+	case OpConstant, OpImport, OpClosure, OpGetAttr:
+		return d.constant(operands[0])
+	case OpGetGlobal, OpSetGlobal:
+		return name(d.bytecode.GlobalNames, operands[0])
+	case OpGetLocal, OpSetLocal:
+		return name(fn.LocalNames, operands[0])
+	case OpAddLocalConst:
+		return name(fn.LocalNames, operands[0]) + " += " + d.constant(operands[1])
+	case OpAddGlobalConst:
+		return name(d.bytecode.GlobalNames, operands[0]) + " += " + d.constant(operands[1])
+	case OpGetFree, OpSetFree:
+		return name(fn.FreeNames, operands[0])
+	case OpGetBuiltin:
+		if operands[0] < len(types.Builtins) {
+			return types.Builtins[operands[0]].Name
+		}
+	}
+	return ""
+}
+
+// constant describes a constant pool entry, queueing functions for listing.
+func (d *disassembler) constant(idx int) string {
+	if idx < 0 || idx >= len(d.bytecode.Constants) {
+		return "invalid constant"
+	}
+	c := d.bytecode.Constants[idx]
+	if s, ok := c.(*types.String); ok {
+		return strconv.Quote(s.Value)
+	}
+	fn, ok := c.(*types.CompiledFunction)
+	if !ok {
+		return c.Inspect()
+	}
+	if !d.listed[idx] {
+		d.listed[idx] = true
+		d.queue = append(d.queue, idx)
+	}
+	return "function " + fn.Name
+}
+
+// names renders n slot names, using the index for unknown ones.
+func names(list []string, n int) string {
+	parts := make([]string, n)
+	for i := range parts {
+		parts[i] = name(list, i)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// name returns names[idx], or the index if the name is not known.
+func name(names []string, idx int) string {
+	if idx < len(names) && names[idx] != "" {
+		return names[idx]
+	}
+	return fmt.Sprintf("#%d", idx)
+}
+
+// signature renders a function's name and parameters with their annotations.
+func signature(fn *types.CompiledFunction) string {
+	if fn.Name == mainName {
+		return fn.Name
+	}
+	params := make([]string, fn.NumParameters)
+	firstDefault := fn.NumParameters - fn.NumDefaults
+	if fn.Variadic {
+		firstDefault--
+	}
+	for i := range params {
+		p := name(fn.ParamNames, i)
+		if i < len(fn.ParamTypes) && fn.ParamTypes[i] != "" {
+			p += ": " + fn.ParamTypes[i]
+		}
+		switch {
+		case fn.Variadic && i == fn.NumParameters-1:
+			p = "..." + p
+		case i >= firstDefault:
+			p += " = ..."
+		}
+		params[i] = p
+	}
+	s := fn.Name + "(" + strings.Join(params, ", ") + ")"
+	if fn.ReturnType != "" {
+		s += " -> " + fn.ReturnType
+	}
+	return s
+}
@@ -0,0 +1,339 @@
+package compiler
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/SethGK/Inscript/internal/types"
+)
+
+// The binary bytecode format starts with a header:
+//
+//	magic          4 bytes, "INSC"
+//	format version uvarint, layout of the rest of the file
+//	opcode version uvarint, numbering and operands of the opcodes
+//
+// followed by the main program and its constant pool. Integers are varints,
+// floats are their IEEE 754 bits, and strings and byte slices are prefixed
+// with their length.
+const (
+	// FormatVersion must be bumped whenever the encoding below changes.
+	FormatVersion = 2
+
+	// OpcodeVersion must be bumped whenever opcodes are added, removed or
+	// renumbered, or their operands change, so that stale files are rejected
+	// instead of misexecuted.
+	OpcodeVersion = 6
+)
+
+var bytecodeMagic = []byte("INSC")
+
+// Constant pool entry tags.
+const (
+	constInteger byte = iota + 1
+	constFloat
+	constString
+	constFunction
+)
+
+// IsBytecode reports whether data starts like an encoded Bytecode.
+func IsBytecode(data []byte) bool {
+	return bytes.HasPrefix(data, bytecodeMagic)
+}
+
+// MarshalBinary encodes the bytecode in the versioned binary format.
+func (b *Bytecode) MarshalBinary() ([]byte, error) {
+	e := &encoder{buf: append([]byte(nil), bytecodeMagic...)}
+	e.uint(FormatVersion)
+	e.uint(OpcodeVersion)
+
+	e.string(b.File)
+	e.uint(b.NumLocals)
+	e.uint(b.NumParameters)
+	e.uint(b.NumGlobals)
+	e.uint(len(b.GlobalNames))
+	for _, name := range b.GlobalNames {
+		e.string(name)
+	}
+	e.bytes(b.Instructions)
+	e.lines(b.Lines)
+	e.handlers(b.Handlers)
+
+	e.uint(len(b.Constants))
+	for i, c := range b.Constants {
+		if err := e.constant(c); err != nil {
+			return nil, fmt.Errorf("constant %d: %w", i, err)
+		}
+	}
+	return e.buf, nil
+}
+
+// UnmarshalBinary decodes bytecode written by MarshalBinary. Files written for
+// another format or opcode version are rejected.
+func (b *Bytecode) UnmarshalBinary(data []byte) error {
+	if !IsBytecode(data) {
+		return errors.New("not an Inscript bytecode file")
+	}
+	d := &decoder{buf: data[len(bytecodeMagic):]}
+	if v := d.uint(); d.err == nil && v != FormatVersion {
+		return fmt.Errorf("bytecode format version %d is not supported (want %d)", v, FormatVersion)
+	}
+	if v := d.uint(); d.err == nil && v != OpcodeVersion {
+		return fmt.Errorf("bytecode was compiled for opcode version %d, but this build runs version %d; rebuild it", v, OpcodeVersion)
+	}
+
+	var out Bytecode
+	out.File = d.string()
+	out.NumLocals = d.uint()
+	out.NumParameters = d.uint()
+	out.NumGlobals = d.uint()
+	out.GlobalNames = make([]string, d.count())
+	for i := range out.GlobalNames {
+		out.GlobalNames[i] = d.string()
+	}
+	out.Instructions = d.bytes()
+	out.Lines = d.lines()
+	out.Handlers = d.handlers()
+
+	out.Constants = make([]types.Value, d.count())
+	for i := range out.Constants {
+		out.Constants[i] = d.constant()
+	}
+	if d.err == nil && len(d.buf) > 0 {
+		d.err = fmt.Errorf("%d trailing bytes", len(d.buf))
+	}
+	if d.err != nil {
+		return fmt.Errorf("corrupt bytecode: %w", d.err)
+	}
+	*b = out
+	return nil
+}
+
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) uint(v int) { e.buf = binary.AppendUvarint(e.buf, uint64(v)) }
+
+func (e *encoder) bool(v bool) {
+	if v {
+		e.buf = append(e.buf, 1)
+	} else {
+		e.buf = append(e.buf, 0)
+	}
+}
+
+func (e *encoder) bytes(v []byte) {
+	e.uint(len(v))
+	e.buf = append(e.buf, v...)
+}
+
+func (e *encoder) string(v string) {
+	e.uint(len(v))
+	e.buf = append(e.buf, v...)
+}
+
+func (e *encoder) strings(v []string) {
+	e.uint(len(v))
+	for _, s := range v {
+		e.string(s)
+	}
+}
+
+func (e *encoder) lines(v []types.LineEntry) {
+	e.uint(len(v))
+	for _, l := range v {
+		e.uint(l.Offset)
+		e.uint(l.Line)
+		e.uint(l.Column)
+	}
+}
+
+func (e *encoder) handlers(v []types.Handler) {
+	e.uint(len(v))
+	for _, h := range v {
+		e.uint(h.Start)
+		e.uint(h.End)
+		e.uint(h.Target)
+		e.uint(h.Depth)
+	}
+}
+
+func (e *encoder) constant(c types.Value) error {
+	switch c := c.(type) {
+	case *types.Integer:
+		e.buf = append(e.buf, constInteger)
+		e.buf = binary.AppendVarint(e.buf, c.Value)
+	case *types.Float:
+		e.buf = append(e.buf, constFloat)
+		e.buf = binary.LittleEndian.AppendUint64(e.buf, math.Float64bits(c.Value))
+	case *types.String:
+		e.buf = append(e.buf, constString)
+		e.string(c.Value)
+	case *types.CompiledFunction:
+		e.buf = append(e.buf, constFunction)
+		e.string(c.Name)
+		e.bytes(c.Instructions)
+		e.uint(c.NumLocals)
+		e.uint(c.NumParameters)
+		e.strings(c.ParamNames)
+		e.uint(c.NumDefaults)
+		e.bool(c.Variadic)
+		e.strings(c.ParamTypes)
+		e.string(c.ReturnType)
+		e.uint(c.FreeCount)
+		e.strings(c.LocalNames)
+		e.strings(c.FreeNames)
+		e.string(c.File)
+		e.lines(c.Lines)
+		e.handlers(c.Handlers)
+	default:
+		return fmt.Errorf("cannot encode constant of type %s", c.Type())
+	}
+	return nil
+}
+
+// decoder reads the values written by encoder. After the first error every
+// read returns a zero value, so callers check err once at the end.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) fail(format string, a ...interface{}) {
+	if d.err == nil {
+		d.err = fmt.Errorf(format, a...)
+	}
+	d.buf = nil
+}
+
+func (d *decoder) uint() int {
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 || v > math.MaxInt32 {
+		d.fail("bad unsigned integer")
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return int(v)
+}
+
+// count reads a length and checks it against the bytes left, so a corrupt
+// file cannot make the decoder allocate more than the file's size.
+func (d *decoder) count() int {
+	n := d.uint()
+	if n > len(d.buf) {
+		d.fail("length %d exceeds the remaining %d bytes", n, len(d.buf))
+		return 0
+	}
+	return n
+}
+
+func (d *decoder) byte() byte {
+	if len(d.buf) == 0 {
+		d.fail("unexpected end of data")
+		return 0
+	}
+	b := d.buf[0]
+	d.buf = d.buf[1:]
+	return b
+}
+
+func (d *decoder) bool() bool { return d.byte() != 0 }
+
+func (d *decoder) bytes() []byte {
+	n := d.count()
+	v := append([]byte(nil), d.buf[:n]...)
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) string() string {
+	n := d.count()
+	v := string(d.buf[:n])
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) strings() []string {
+	n := d.count()
+	if n == 0 {
+		return nil
+	}
+	v := make([]string, n)
+	for i := range v {
+		v[i] = d.string()
+	}
+	return v
+}
+
+func (d *decoder) lines() []types.LineEntry {
+	n := d.count()
+	if n == 0 {
+		return nil
+	}
+	v := make([]types.LineEntry, n)
+	for i := range v {
+		v[i] = types.LineEntry{Offset: d.uint(), Line: d.uint(), Column: d.uint()}
+	}
+	return v
+}
+
+func (d *decoder) handlers() []types.Handler {
+	n := d.count()
+	if n == 0 {
+		return nil
+	}
+	v := make([]types.Handler, n)
+	for i := range v {
+		v[i] = types.Handler{Start: d.uint(), End: d.uint(), Target: d.uint(), Depth: d.uint()}
+	}
+	return v
+}
+
+func (d *decoder) constant() types.Value {
+	switch tag := d.byte(); tag {
+	case constInteger:
+		v, n := binary.Varint(d.buf)
+		if n <= 0 {
+			d.fail("bad integer constant")
+			return nil
+		}
+		d.buf = d.buf[n:]
+		return types.NewInteger(v)
+	case constFloat:
+		if len(d.buf) < 8 {
+			d.fail("unexpected end of data")
+			return nil
+		}
+		v := math.Float64frombits(binary.LittleEndian.Uint64(d.buf))
+		d.buf = d.buf[8:]
+		return types.NewFloat(v)
+	case constString:
+		return types.NewString(d.string())
+	case constFunction:
+		fn := &types.CompiledFunction{Name: d.string()}
+		fn.Instructions = d.bytes()
+		fn.NumLocals = d.uint()
+		fn.NumParameters = d.uint()
+		fn.ParamNames = d.strings()
+		fn.NumDefaults = d.uint()
+		fn.Variadic = d.bool()
+		fn.ParamTypes = d.strings()
+		fn.ReturnType = d.string()
+		fn.FreeCount = d.uint()
+		fn.LocalNames = d.strings()
+		fn.FreeNames = d.strings()
+		fn.File = d.string()
+		fn.Lines = d.lines()
+		fn.Handlers = d.handlers()
+		return fn
+	default:
+		if d.err == nil {
+			d.fail("unknown constant tag %d", tag)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,78 @@
+package compiler
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestMarshalRoundTrip(t *testing.T) {
+	for name, src := range programs {
+		original := compile(t, name, src, true)
+		data, err := original.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%s: marshal: %v", name, err)
+		}
+		if !IsBytecode(data) {
+			t.Errorf("%s: encoded bytecode does not start with the magic number", name)
+		}
+
+		var decoded Bytecode
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("%s: unmarshal: %v", name, err)
+		}
+		if got, want := disassemble(t, &decoded), disassemble(t, original); got != want {
+			t.Errorf("%s: listing changed on round trip:\n--- before\n%s\n--- after\n%s", name, want, got)
+		}
+		again, err := decoded.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%s: marshal decoded: %v", name, err)
+		}
+		if !bytes.Equal(again, data) {
+			t.Errorf("%s: encoding changed on round trip", name)
+		}
+	}
+}
+
+func TestUnmarshalCorrupt(t *testing.T) {
+	data, err := compile(t, "literals", programs["literals"], true).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := len(bytecodeMagic)
+
+	tests := []struct {
+		name string
+		data []byte
+		err  string
+	}{
+		{"empty", nil, "not an Inscript bytecode file"},
+		{"source", []byte("print(1)"), "not an Inscript bytecode file"},
+		{"format version", withByte(data, header, FormatVersion+1), "format version"},
+		{"opcode version", withByte(data, header+1, OpcodeVersion+1), "opcode version"},
+		{"trailing bytes", append(append([]byte(nil), data...), 0), "1 trailing bytes"},
+		{"huge length", append(append([]byte(nil), data[:header+2]...), 0xff, 0xff, 0xff, 0x7f), "corrupt bytecode"},
+	}
+	for _, tt := range tests {
+		var b Bytecode
+		err := b.UnmarshalBinary(tt.data)
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("%s: UnmarshalBinary = %v, want an error containing %q", tt.name, err, tt.err)
+		}
+	}
+
+	// Every truncation must be reported, not decoded or panicked on.
+	for n := 0; n < len(data); n++ {
+		var b Bytecode
+		if err := b.UnmarshalBinary(data[:n]); err == nil {
+			t.Errorf("UnmarshalBinary accepted the first %d of %d bytes", n, len(data))
+		}
+	}
+}
+
+// withByte returns a copy of data with the byte at i replaced.
+func withByte(data []byte, i int, v byte) []byte {
+	out := append([]byte(nil), data...)
+	out[i] = v
+	return out
+}
@@ -0,0 +1,222 @@
+package compiler
+
+import (
+	"math"
+
+	"github.com/SethGK/Inscript/internal/ast"
+	"github.com/SethGK/Inscript/internal/types"
+)
+
+// binaryOps maps binary operators to their opcodes.
+var binaryOps = map[string]Opcode{
+	"+":  OpAdd,
+	"-":  OpSub,
+	"*":  OpMul,
+	"/":  OpDiv,
+	"%":  OpMod,
+	"^^": OpPow,
+	"//": OpIDiv,
+	"&":  OpBitAnd,
+	"|":  OpBitOr,
+	"^":  OpBitXor,
+	"<<": OpShl,
+	">>": OpShr,
+	"==": OpEqual,
+	"!=": OpNotEqual,
+	"<":  OpLessThan,
+	"<=": OpLessEqual,
+	">":  OpGreaterThan,
+	">=": OpGreaterEqual,
+}
+
+// constantValue returns the value of an expression made only of literals and
+// operators, computed the way the VM would. It reports false for anything
+// else, and for operations that would fail at run time, so that the error is
+// still raised where and when the program runs.
+func constantValue(e ast.Expression) (types.Value, bool) {
+	switch expr := e.(type) {
+	case *ast.IntegerLiteral:
+		return types.NewInteger(expr.Value), true
+	case *ast.FloatLiteral:
+		return types.NewFloat(expr.Value), true
+	case *ast.StringLiteral:
+		return types.NewString(expr.Value), true
+	case *ast.BooleanLiteral:
+		return types.NewBoolean(expr.Value), true
+	case *ast.NilLiteral:
+		return &types.Nil{}, true
+	case *ast.UnaryExpr:
+		operand, ok := constantValue(expr.Expr)
+		if !ok {
+			return nil, false
+		}
+		return foldUnary(expr.Operator.Literal, operand)
+	case *ast.BinaryExpr:
+		left, ok := constantValue(expr.Left)
+		if !ok {
+			return nil, false
+		}
+		switch expr.Operator.Literal {
+		case "and":
+			if !truthy(left) {
+				return left, true
+			}
+			return constantValue(expr.Right)
+		case "or":
+			if truthy(left) {
+				return left, true
+			}
+			return constantValue(expr.Right)
+		}
+		right, ok := constantValue(expr.Right)
+		if !ok {
+			return nil, false
+		}
+		op, ok := binaryOps[expr.Operator.Literal]
+		if !ok {
+			return nil, false
+		}
+		return foldBinary(op, left, right)
+	}
+	return nil, false
+}
+
+// truthy mirrors the VM's truthiness: only false and nil are false.
+func truthy(v types.Value) bool {
+	switch v := v.(type) {
+	case *types.Boolean:
+		return v.Value
+	case *types.Nil:
+		return false
+	}
+	return true
+}
+
+func foldUnary(operator string, v types.Value) (types.Value, bool) {
+	switch operator {
+	case "not":
+		return types.NewBoolean(!truthy(v)), true
+	case "-":
+		switch v := v.(type) {
+		case *types.Integer:
+			return types.NewInteger(-v.Value), true
+		case *types.Float:
+			return types.NewFloat(-v.Value), true
+		}
+	case "~":
+		if v, ok := v.(*types.Integer); ok {
+			return types.NewInteger(^v.Value), true
+		}
+	}
+	return nil, false
+}
+
+func foldBinary(op Opcode, left, right types.Value) (types.Value, bool) {
+	switch op {
+	case OpEqual:
+		return types.NewBoolean(left.Equals(right)), true
+	case OpNotEqual:
+		return types.NewBoolean(!left.Equals(right)), true
+	case OpLessThan, OpLessEqual, OpGreaterThan, OpGreaterEqual:
+		cmp, err := left.Compare(right)
+		if err != nil {
+			return nil, false
+		}
+		switch op {
+		case OpLessThan:
+			return types.NewBoolean(cmp < 0), true
+		case OpLessEqual:
+			return types.NewBoolean(cmp <= 0), true
+		case OpGreaterThan:
+			return types.NewBoolean(cmp > 0), true
+		}
+		return types.NewBoolean(cmp >= 0), true
+	}
+
+	if l, ok := left.(*types.String); ok && op == OpAdd {
+		if r, ok := right.(*types.String); ok {
+			return types.NewString(l.Value + r.Value), true
+		}
+		return nil, false
+	}
+
+	li, lInt := left.(*types.Integer)
+	ri, rInt := right.(*types.Integer)
+	switch op {
+	case OpBitAnd, OpBitOr, OpBitXor, OpShl, OpShr:
+		if !lInt || !rInt {
+			return nil, false
+		}
+		switch op {
+		case OpBitAnd:
+			return types.NewInteger(li.Value & ri.Value), true
+		case OpBitOr:
+			return types.NewInteger(li.Value | ri.Value), true
+		case OpBitXor:
+			return types.NewInteger(li.Value ^ ri.Value), true
+		}
+		if ri.Value < 0 {
+			return nil, false
+		}
+		if op == OpShl {
+			return types.NewInteger(li.Value << uint(ri.Value)), true
+		}
+		return types.NewInteger(li.Value >> uint(ri.Value)), true
+	}
+
+	l, lNum := number(left)
+	r, rNum := number(right)
+	if !lNum || !rNum {
+		return nil, false
+	}
+	bothInt := lInt && rInt
+	switch op {
+	case OpAdd:
+		if bothInt {
+			return types.NewInteger(li.Value + ri.Value), true
+		}
+		return types.NewFloat(l + r), true
+	case OpSub:
+		if bothInt {
+			return types.NewInteger(li.Value - ri.Value), true
+		}
+		return types.NewFloat(l - r), true
+	case OpMul:
+		if bothInt {
+			return types.NewInteger(li.Value * ri.Value), true
+		}
+		return types.NewFloat(l * r), true
+	case OpDiv:
+		if r == 0 {
+			return nil, false
+		}
+		return types.NewFloat(l / r), true
+	case OpMod:
+		if r == 0 {
+			return nil, false
+		}
+		if bothInt {
+			return types.NewInteger(li.Value % ri.Value), true
+		}
+		return types.NewFloat(math.Mod(l, r)), true
+	case OpPow:
+		return types.NewFloat(math.Pow(l, r)), true
+	case OpIDiv:
+		if r == 0 {
+			return nil, false
+		}
+		return types.NewInteger(int64(math.Floor(l / r))), true
+	}
+	return nil, false
+}
+
+// number returns the value of an integer or float as a float64.
+func number(v types.Value) (float64, bool) {
+	switch v := v.(type) {
+	case *types.Integer:
+		return float64(v.Value), true
+	case *types.Float:
+		return v.Value, true
+	}
+	return 0, false
+}
@@ -0,0 +1,183 @@
+package compiler
+
+import "github.com/SethGK/Inscript/internal/types"
+
+// compareJumps maps each comparison to the superinstruction that compares and
+// jumps when the comparison is false.
+var compareJumps = map[Opcode]Opcode{
+	OpEqual:        OpEqualJump,
+	OpNotEqual:     OpNotEqualJump,
+	OpLessThan:     OpLessThanJump,
+	OpLessEqual:    OpLessEqualJump,
+	OpGreaterThan:  OpGreaterThanJump,
+	OpGreaterEqual: OpGreaterEqualJump,
+}
+
+// instruction is a decoded instruction being rewritten by peephole.
+type instruction struct {
+	op       Opcode
+	operands []int
+	offset   int  // Offset in the original instructions
+	target   int  // Original offset a jump goes to
+	primary  int  // Original offset whose source position the instruction reports
+	dead     bool // Fused into an earlier instruction or removed
+}
+
+// instructionSize returns the encoded size of an instruction.
+func instructionSize(op Opcode) int {
+	size := 1
+	for _, w := range operandWidths[op] {
+		size += w
+	}
+	return size
+}
+
+// peephole rewrites common instruction sequences of one function into
+// superinstructions, so the VM dispatches fewer instructions:
+//
+//	OpJumpNotTruthy L; OpPop ... L: OpPop              => OpPopJumpIfFalse L
+//	OpLessThan; OpPopJumpIfFalse L                     => OpLessThanJump L (and the other comparisons)
+//	OpGetLocal a; OpConstant k; OpAdd; OpSetLocal a    => OpAddLocalConst a k
+//	OpGetGlobal g; OpConstant k; OpAdd; OpSetGlobal g  => OpAddGlobalConst g k
+//
+// A sequence is only fused when no jump or handler enters it after its first
+// instruction. A fused instruction reports the source position of the
+// instruction in the sequence that can fail, so error positions and
+// tracebacks do not change. Jump offsets, the line table and the handlers are
+// rewritten for the new layout.
+func peephole(ins Instructions, lines []types.LineEntry, handlers []types.Handler) (Instructions, []types.LineEntry, []types.Handler) {
+	var code []*instruction
+	index := make(map[int]int) // Original offset to index in code
+	refs := make(map[int]int)  // Number of jumps and handler boundaries at each offset
+	for i := 0; i < len(ins); {
+		op := ReadOpcode(ins, i)
+		operands, n := ReadOperands(op, ins, i+1)
+		in := &instruction{op: op, operands: operands, offset: i, primary: i}
+		if IsJump(op) {
+			in.target = i + 1 + n + operands[0]
+			refs[in.target]++
+		}
+		index[i] = len(code)
+		code = append(code, in)
+		i += 1 + n
+	}
+	for _, h := range handlers {
+		refs[h.Start]++
+		refs[h.End]++
+		refs[h.Target]++
+	}
+	// fusable reports whether the instructions from code[i] on are live, have the
+	// given opcodes and are entered only through code[i].
+	fusable := func(i int, ops ...Opcode) bool {
+		if i+len(ops) > len(code) {
+			return false
+		}
+		for k, op := range ops {
+			in := code[i+k]
+			if in.dead || in.op != op || (k > 0 && refs[in.offset] > 0) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// An if statement or while loop pops its condition on both paths. When the
+	// false path's OpPop is reached only by the jump, both pops go into the jump.
+	for i, in := range code {
+		if !fusable(i, OpJumpNotTruthy, OpPop) {
+			continue
+		}
+		t, ok := index[in.target]
+		if !ok || t == 0 || refs[in.target] != 1 {
+			continue
+		}
+		target, before := code[t], code[t-1]
+		if target.op != OpPop || before.dead || !terminates(before.op) {
+			continue
+		}
+		in.op = OpPopJumpIfFalse
+		code[i+1].dead = true
+		target.dead = true
+	}
+
+	for i, in := range code {
+		if jump, ok := compareJumps[in.op]; ok && fusable(i, in.op, OpPopJumpIfFalse) {
+			in.op, in.target = jump, code[i+1].target
+			in.operands = []int{0}
+			code[i+1].dead = true
+			continue
+		}
+		for _, p := range [][]Opcode{
+			{OpGetLocal, OpConstant, OpAdd, OpSetLocal},
+			{OpGetGlobal, OpConstant, OpAdd, OpSetGlobal},
+		} {
+			if !fusable(i, p...) || code[i+3].operands[0] != in.operands[0] {
+				continue
+			}
+			fused := OpAddLocalConst
+			if p[0] == OpGetGlobal {
+				fused = OpAddGlobalConst
+			}
+			in.op = fused
+			in.operands = []int{in.operands[0], code[i+1].operands[0]}
+			in.primary = code[i+2].offset // The addition is what can fail
+			for _, next := range code[i+1 : i+4] {
+				next.dead = true
+			}
+		}
+	}
+
+	// Removed instructions map to the next live one.
+	newOffset := make(map[int]int, len(code)+1)
+	pos := 0
+	for _, in := range code {
+		newOffset[in.offset] = pos
+		if !in.dead {
+			pos += instructionSize(in.op)
+		}
+	}
+	newOffset[len(ins)] = pos
+
+	out := make(Instructions, 0, pos)
+	var outLines []types.LineEntry
+	line := 0 // Index of the line entry in effect, plus one
+	for _, in := range code {
+		if in.dead {
+			continue
+		}
+		if IsJump(in.op) {
+			in.operands[0] = newOffset[in.target] - (len(out) + instructionSize(in.op))
+		}
+		for line < len(lines) && lines[line].Offset <= in.primary {
+			line++
+		}
+		if line > 0 {
+			l := lines[line-1]
+			if n := len(outLines); n == 0 || outLines[n-1].Line != l.Line || outLines[n-1].Column != l.Column {
+				outLines = append(outLines, types.LineEntry{Offset: len(out), Line: l.Line, Column: l.Column})
+			}
+		}
+		out = append(out, Make(in.op, in.operands...)...)
+	}
+
+	var outHandlers []types.Handler
+	for _, h := range handlers {
+		outHandlers = append(outHandlers, types.Handler{
+			Start:  newOffset[h.Start],
+			End:    newOffset[h.End],
+			Target: newOffset[h.Target],
+			Depth:  h.Depth,
+		})
+	}
+	return out, outLines, outHandlers
+}
+
+// terminates reports whether control never falls through op to the next
+// instruction.
+func terminates(op Opcode) bool {
+	switch op {
+	case OpJump, OpReturn, OpReturnValue, OpThrow:
+		return true
+	}
+	return false
+}
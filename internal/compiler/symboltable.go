@@ -1,6 +1,10 @@
 package compiler
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/SethGK/Inscript/internal/types"
+)
 
 // SymbolKind represents the kind of a symbol (global, local, parameter, builtin, or free).
 type SymbolKind string
@@ -41,6 +45,15 @@ func NewSymbolTable() *SymbolTable {
 	}
 }
 
+// NewGlobalSymbolTable creates a global symbol table with every builtin defined.
+func NewGlobalSymbolTable() *SymbolTable {
+	s := NewSymbolTable()
+	for i, def := range types.Builtins {
+		s.DefineBuiltin(i, def.Name)
+	}
+	return s
+}
+
 // NewEnclosedSymbolTable creates a new nested symbol table; isFunc indicates function scope.
 func NewEnclosedSymbolTable(outer *SymbolTable, isFunc bool) *SymbolTable {
 	return &SymbolTable{
@@ -161,6 +174,32 @@ func (s *SymbolTable) NumGlobalsInTable() int {
 	return s.nextGlobalIndex
 }
 
+// GlobalNames returns the names of the globals defined in this table, ordered by slot index.
+func (s *SymbolTable) GlobalNames() []string {
+	if s.Outer != nil {
+		panic("GlobalNames called on a non-global symbol table")
+	}
+	names := make([]string, s.nextGlobalIndex)
+	for name, sym := range s.store {
+		if sym.Kind == Global {
+			names[sym.Index] = name
+		}
+	}
+	return names
+}
+
+// LocalNames returns the names of the locals and parameters defined in this
+// table, ordered by slot index.
+func (s *SymbolTable) LocalNames() []string {
+	names := make([]string, s.numLocalAndParamDefinitions)
+	for name, sym := range s.store {
+		if sym.Kind == Local || sym.Kind == Parameter {
+			names[sym.Index] = name
+		}
+	}
+	return names
+}
+
 // Debug prints the symbol table for development.
 func (s *SymbolTable) Debug() {
 	fmt.Printf("SymbolTable %p (funcScope=%v, numLocalAndParamDefs=%d, nextGlobalIdx=%d):\n", s, s.isFunctionScope, s.numLocalAndParamDefinitions, s.nextGlobalIndex)
@@ -0,0 +1,367 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/SethGK/Inscript/internal/types"
+)
+
+// VerifyError describes bytecode that Verify rejected: the function and
+// instruction offset at fault, with the source position of that instruction
+// when the line table has one.
+type VerifyError struct {
+	Function string
+	Offset   int // -1 for errors about the function as a whole
+	Pos      types.Position
+	Msg      string
+}
+
+func (e *VerifyError) Error() string {
+	msg := "invalid bytecode in " + e.Function
+	if e.Offset >= 0 {
+		msg += fmt.Sprintf(" at %04d", e.Offset)
+	}
+	msg += ": " + e.Msg
+	if e.Pos.File != "" || e.Pos.IsValid() {
+		msg = e.Pos.String() + ": " + msg
+	}
+	return msg
+}
+
+// Verify checks that bytecode is safe to run: every opcode is known and
+// complete, jumps and handlers land on instruction boundaries, constant,
+// global, local, free and builtin operands are in range, and the operand
+// stack never underflows and has the same depth wherever paths meet. The main
+// program must keep its variables in globals: the VM reserves no local slots
+// for it. The VM runs Verify before executing anything, so bytecode loaded from
+// disk cannot make it index out of bounds.
+func Verify(b *Bytecode) error {
+	if b.NumLocals != 0 {
+		return &VerifyError{
+			Function: mainName,
+			Offset:   -1,
+			Pos:      types.Position{File: b.File},
+			Msg:      fmt.Sprintf("%d locals, but the main program cannot have locals", b.NumLocals),
+		}
+	}
+	main := &types.CompiledFunction{
+		Name:         mainName,
+		Instructions: b.Instructions,
+		NumLocals:    b.NumLocals,
+		File:         b.File,
+		Lines:        b.Lines,
+		Handlers:     b.Handlers,
+	}
+	if err := verifyFunction(b, main); err != nil {
+		return err
+	}
+	for _, c := range b.Constants {
+		if fn, ok := c.(*types.CompiledFunction); ok {
+			if err := verifyFunction(b, fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// verifier checks one function.
+type verifier struct {
+	b      *Bytecode
+	fn     *types.CompiledFunction
+	ins    Instructions
+	starts map[int]bool // Offsets where instructions start
+	depth  map[int]int  // Operand stack depth on entry, for reached instructions
+	work   []int        // Reached offsets still to check
+}
+
+func (v *verifier) errorf(offset int, format string, args ...interface{}) error {
+	err := &VerifyError{Function: v.fn.Name, Offset: offset, Msg: fmt.Sprintf(format, args...)}
+	if offset >= 0 {
+		err.Pos = v.fn.PositionAt(offset)
+	} else {
+		err.Pos.File = v.fn.File
+	}
+	return err
+}
+
+func verifyFunction(b *Bytecode, fn *types.CompiledFunction) error {
+	v := &verifier{
+		b:      b,
+		fn:     fn,
+		ins:    Instructions(fn.Instructions),
+		starts: make(map[int]bool),
+		depth:  make(map[int]int),
+	}
+	if err := v.header(); err != nil {
+		return err
+	}
+	if err := v.decode(); err != nil {
+		return err
+	}
+	for _, h := range fn.Handlers {
+		if !v.boundary(h.Start) || !v.boundary(h.End) || h.Start >= h.End || !v.starts[h.Target] {
+			return v.errorf(-1, "handler %04d-%04d -> %04d does not cover whole instructions", h.Start, h.End, h.Target)
+		}
+	}
+	return v.stack()
+}
+
+// header checks the counts that calls rely on to bind arguments.
+func (v *verifier) header() error {
+	fn := v.fn
+	fixed := fn.NumParameters
+	if fn.Variadic {
+		fixed--
+	}
+	switch {
+	case fixed < 0:
+		return v.errorf(-1, "variadic function without parameters")
+	case fn.NumParameters > fn.NumLocals:
+		return v.errorf(-1, "%d parameters but only %d locals", fn.NumParameters, fn.NumLocals)
+	case len(fn.ParamNames) != fn.NumParameters:
+		return v.errorf(-1, "%d parameter names for %d parameters", len(fn.ParamNames), fn.NumParameters)
+	case fn.ParamTypes != nil && len(fn.ParamTypes) != fn.NumParameters:
+		return v.errorf(-1, "%d parameter types for %d parameters", len(fn.ParamTypes), fn.NumParameters)
+	case fn.NumDefaults > fixed:
+		return v.errorf(-1, "%d defaults for %d parameters", fn.NumDefaults, fixed)
+	}
+	return nil
+}
+
+// boundary reports whether offset is the start or the end of an instruction.
+func (v *verifier) boundary(offset int) bool {
+	return v.starts[offset] || offset == len(v.ins)
+}
+
+// decode checks every instruction in order, including unreachable ones, and
+// records where each one starts.
+func (v *verifier) decode() error {
+	for i := 0; i < len(v.ins); {
+		v.starts[i] = true
+		op := ReadOpcode(v.ins, i)
+		widths, ok := operandWidths[op]
+		if !ok {
+			return v.errorf(i, "unknown opcode %d", op)
+		}
+		size := 1
+		for _, w := range widths {
+			size += w
+		}
+		if i+size > len(v.ins) {
+			return v.errorf(i, "%s is truncated", op)
+		}
+		operands, _ := ReadOperands(op, v.ins, i+1)
+		if err := v.operands(i, op, operands); err != nil {
+			return err
+		}
+		i += size
+	}
+	// Jump targets are checked once every instruction start is known.
+	for i := 0; i < len(v.ins); {
+		op := ReadOpcode(v.ins, i)
+		operands, n := ReadOperands(op, v.ins, i+1)
+		next := i + 1 + n
+		if IsJump(op) && !v.boundary(next+operands[0]) {
+			return v.errorf(i, "%s jumps to %04d, which is not an instruction boundary", op, next+operands[0])
+		}
+		i = next
+	}
+	return nil
+}
+
+// operands checks that the operands of the instruction at offset refer to
+// things that exist.
+func (v *verifier) operands(offset int, op Opcode, operands []int) error {
+	fn, b := v.fn, v.b
+	inRange := func(what string, idx, n int) error {
+		if idx < 0 || idx >= n {
+			return v.errorf(offset, "%s %s %d out of range (have %d)", op, what, idx, n)
+		}
+		return nil
+	}
+	// 2-byte operands are signed, so counts can come out negative.
+	switch op {
+	case OpArray, OpTuple, OpTable, OpUnpack, OpCall, OpCallKw, OpPrint:
+		if operands[0] < 0 {
+			return v.errorf(offset, "%s count %d is negative", op, operands[0])
+		}
+		if op == OpCallKw && operands[1] < 0 {
+			return v.errorf(offset, "%s count %d is negative", op, operands[1])
+		}
+	}
+	switch op {
+	case OpConstant:
+		return inRange("constant", operands[0], len(b.Constants))
+	case OpImport, OpGetAttr:
+		if err := inRange("constant", operands[0], len(b.Constants)); err != nil {
+			return err
+		}
+		if _, ok := b.Constants[operands[0]].(*types.String); !ok {
+			return v.errorf(offset, "%s constant %d is not a string", op, operands[0])
+		}
+	case OpClosure:
+		if err := inRange("constant", operands[0], len(b.Constants)); err != nil {
+			return err
+		}
+		callee, ok := b.Constants[operands[0]].(*types.CompiledFunction)
+		if !ok {
+			return v.errorf(offset, "%s constant %d is not a function", op, operands[0])
+		}
+		if operands[1] != callee.FreeCount {
+			return v.errorf(offset, "%s captures %d values, but %s has %d free variables", op, operands[1], callee.Name, callee.FreeCount)
+		}
+	case OpUnpack:
+		return inRange("rest target", operands[1], operands[0]+1)
+	case OpGetGlobal, OpSetGlobal:
+		return inRange("global", operands[0], b.NumGlobals)
+	case OpGetLocal, OpSetLocal:
+		return inRange("local", operands[0], fn.NumLocals)
+	case OpAddLocalConst:
+		if err := inRange("local", operands[0], fn.NumLocals); err != nil {
+			return err
+		}
+		return inRange("constant", operands[1], len(b.Constants))
+	case OpAddGlobalConst:
+		if err := inRange("global", operands[0], b.NumGlobals); err != nil {
+			return err
+		}
+		return inRange("constant", operands[1], len(b.Constants))
+	case OpJumpIfBound:
+		return inRange("parameter", operands[1], fn.NumParameters)
+	case OpGetFree, OpSetFree:
+		return inRange("free variable", operands[0], fn.FreeCount)
+	case OpGetBuiltin:
+		return inRange("builtin", operands[0], len(types.Builtins))
+	}
+	return nil
+}
+
+// stackEffect returns how many values the instruction pops and pushes when
+// it falls through to the next instruction.
+func stackEffect(op Opcode, operands []int) (pops, pushes int) {
+	switch op {
+	case OpPop, OpSetGlobal, OpSetLocal, OpSetFree:
+		return 1, 0
+	case OpConstant, OpTrue, OpFalse, OpNull, OpGetGlobal, OpGetLocal, OpGetFree, OpGetBuiltin, OpImport:
+		return 0, 1
+	case OpBang, OpMinus, OpBitNot, OpGetIter, OpGetAttr:
+		return 1, 1
+	case OpJumpNotTruthy, OpJumpTruthy: // The condition is peeked, not popped
+		return 1, 1
+	case OpIterNext: // Pushes the next value; the exit jump pops the iterator
+		return 1, 2
+	case OpIndex:
+		return 2, 1
+	case OpDupTwo:
+		return 2, 4
+	case OpSetIndex:
+		return 3, 1
+	case OpStoreIndex:
+		return 3, 0
+	case OpUnpack:
+		return 1, operands[0]
+	case OpArray, OpTuple:
+		return operands[0], 1
+	case OpClosure:
+		return operands[1], 1
+	case OpTable:
+		return 2 * operands[0], 1
+	case OpPrint:
+		return operands[0], 0
+	case OpCall:
+		return operands[0] + 1, 1
+	case OpCallKw:
+		return operands[0] + 2*operands[1] + 1, 1
+	case OpReturnValue, OpThrow:
+		return 1, 0
+	case OpPopJumpIfFalse:
+		return 1, 0
+	case OpEqualJump, OpNotEqualJump, OpLessThanJump, OpLessEqualJump, OpGreaterThanJump, OpGreaterEqualJump:
+		return 2, 0
+	case OpJump, OpJumpIfBound, OpReturn, OpAddLocalConst, OpAddGlobalConst:
+		return 0, 0
+	}
+	return 2, 1 // Binary operators
+}
+
+// stack follows every path from the entry point and the handlers, tracking the
+// depth of the operand stack.
+func (v *verifier) stack() error {
+	if err := v.reach(0, 0, -1); err != nil {
+		return err
+	}
+	for _, h := range v.fn.Handlers {
+		if err := v.reach(h.Target, h.Depth+1, -1); err != nil { // The error is pushed
+			return err
+		}
+	}
+	for len(v.work) > 0 {
+		offset := v.work[len(v.work)-1]
+		v.work = v.work[:len(v.work)-1]
+		if offset == len(v.ins) {
+			continue // Falling off the end returns nil
+		}
+		depth := v.depth[offset]
+
+		op := ReadOpcode(v.ins, offset)
+		operands, n := ReadOperands(op, v.ins, offset+1)
+		next := offset + 1 + n
+		pops, pushes := stackEffect(op, operands)
+		if depth < pops {
+			return v.errorf(offset, "%s pops %d values, but the stack holds only %d", op, pops, depth)
+		}
+		for _, h := range v.fn.Handlers {
+			if h.Start <= offset && offset < h.End && depth < h.Depth {
+				return v.errorf(offset, "stack depth %d is below the depth %d of the handler at %04d", depth, h.Depth, h.Target)
+			}
+		}
+		after := depth - pops + pushes
+
+		switch op {
+		case OpReturnValue, OpReturn, OpThrow:
+			continue
+		case OpJump:
+			if err := v.reach(next+operands[0], depth, offset); err != nil {
+				return err
+			}
+			continue
+		case OpIterNext:
+			if err := v.reach(next+operands[0], depth-1, offset); err != nil {
+				return err
+			}
+		case OpJumpNotTruthy, OpJumpTruthy, OpJumpIfBound:
+			if err := v.reach(next+operands[0], depth, offset); err != nil {
+				return err
+			}
+		default:
+			if IsJump(op) { // Superinstructions pop their operands on both paths
+				if err := v.reach(next+operands[0], after, offset); err != nil {
+					return err
+				}
+			}
+		}
+		if err := v.reach(next, after, offset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reach records that offset is reached from the instruction at from with the
+// given stack depth, queueing it if it is reached for the first time.
+func (v *verifier) reach(offset, depth, from int) error {
+	if !v.boundary(offset) {
+		return v.errorf(from, "target %04d is not an instruction boundary", offset)
+	}
+	known, ok := v.depth[offset]
+	if !ok {
+		v.depth[offset] = depth
+		v.work = append(v.work, offset)
+		return nil
+	}
+	if known != depth {
+		return v.errorf(offset, "stack depth is %d on one path and %d on another", known, depth)
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+// Package loader resolves, compiles and runs the modules named by import statements.
+package loader
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/SethGK/Inscript/internal/ast"
+	"github.com/SethGK/Inscript/internal/compiler"
+	"github.com/SethGK/Inscript/internal/types"
+	"github.com/SethGK/Inscript/internal/vm"
+)
+
+// SourceExt and BytecodeExt are the file extensions tried, in that order,
+// when an import path has none.
+const (
+	SourceExt   = ".ins"
+	BytecodeExt = ".insc"
+)
+
+// PathEnv names the environment variable holding extra module search paths,
+// separated like PATH.
+const PathEnv = "INSCRIPT_PATH"
+
+// Loader implements vm.Importer. Each module is run once; later imports of the
+// same file (by canonical path) return the cached module table.
+type Loader struct {
+	SearchPaths []string        // Directories searched after the importing file's directory
+	Output      io.Writer       // Destination of print statements in imported modules; nil means stdout
+	Limits      vm.Limits       // Limits applied to each imported module's run; see ImportMetered
+	Context     context.Context // Context imported modules run under; nil means context.Background()
+
+	modules map[string]*types.Table // Canonical path -> exported globals
+	loading []string                // Canonical paths of the modules being run, outermost first
+}
+
+// New creates a loader for a program whose entry point is mainFile. Relative
+// imports in the main program resolve against mainFile's directory, or against
+// the working directory when mainFile is empty.
+func New(mainFile string, searchPaths ...string) *Loader {
+	l := &Loader{
+		SearchPaths: searchPaths,
+		modules:     make(map[string]*types.Table),
+	}
+	if mainFile != "" {
+		if canonical, err := canonicalPath(mainFile); err == nil {
+			l.loading = append(l.loading, canonical)
+		}
+	}
+	return l
+}
+
+// SearchPathsFromEnv returns the directories listed in INSCRIPT_PATH.
+func SearchPathsFromEnv() []string {
+	var paths []string
+	for _, dir := range filepath.SplitList(os.Getenv(PathEnv)) {
+		if dir != "" {
+			paths = append(paths, dir)
+		}
+	}
+	return paths
+}
+
+// Import loads the module named by path and returns its globals as a table.
+func (l *Loader) Import(path string) (types.Value, error) {
+	return l.ImportMetered(path, nil)
+}
+
+// ImportMetered is like Import, but counts the instructions the module runs
+// in *executed, the counter of the importing run, so that a script cannot
+// escape Limits.MaxInstructions by moving work into imports. A nil executed
+// gives the module a budget of its own.
+func (l *Loader) ImportMetered(path string, executed *int64) (types.Value, error) {
+	canonical, err := l.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if table, ok := l.modules[canonical]; ok {
+		return table, nil
+	}
+
+	for i, loading := range l.loading {
+		if loading == canonical {
+			cycle := make([]string, 0, len(l.loading)-i+1)
+			for _, p := range l.loading[i:] {
+				cycle = append(cycle, displayPath(p))
+			}
+			cycle = append(cycle, displayPath(canonical))
+			return nil, fmt.Errorf("circular import: %s", strings.Join(cycle, " -> "))
+		}
+	}
+
+	l.loading = append(l.loading, canonical)
+	defer func() { l.loading = l.loading[:len(l.loading)-1] }()
+
+	table, err := l.run(canonical, executed)
+	if err != nil {
+		return nil, err
+	}
+	l.modules[canonical] = table
+	return table, nil
+}
+
+// run compiles and executes a module, collecting its globals into a table.
+// Modules compiled ahead of time are decoded instead of parsed.
+func (l *Loader) run(path string, executed *int64) (*types.Table, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("import %s: %v", displayPath(path), err)
+	}
+
+	bytecode := new(compiler.Bytecode)
+	if compiler.IsBytecode(src) {
+		if err := bytecode.UnmarshalBinary(src); err != nil {
+			return nil, fmt.Errorf("import %s: %v", displayPath(path), err)
+		}
+	} else {
+		program, err := ast.ParseFile(displayPath(path), string(src))
+		if err != nil {
+			return nil, err // Syntax errors already name the file
+		}
+
+		bytecode, err = compiler.New().Compile(program)
+		if err != nil {
+			return nil, err // Compile errors already name the file
+		}
+	}
+
+	machine := vm.New(bytecode)
+	machine.SetImporter(l)
+	if l.Output != nil {
+		machine.SetOutput(l.Output)
+	}
+	machine.SetLimits(l.Limits)
+	machine.SetInstructionCounter(executed)
+	machine.Module().Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	ctx := l.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := machine.RunContext(ctx); err != nil {
+		return nil, err // Runtime errors already name the file
+	}
+
+	globals := machine.Module().Globals
+	pairs := make([]types.TablePair, 0, len(globals))
+	for i, name := range bytecode.GlobalNames {
+		if globals[i] == nil {
+			continue // Declared on a branch that never ran
+		}
+		pairs = append(pairs, types.TablePair{Key: types.NewString(name), Value: globals[i]})
+	}
+	return types.NewTable(pairs), nil
+}
+
+// resolve finds the file an import path refers to: absolute paths are used as is,
+// relative paths are tried against the importing module's directory and then each
+// search path, as given and with the .ins and .insc extensions.
+func (l *Loader) resolve(path string) (string, error) {
+	var dirs []string
+	if filepath.IsAbs(path) {
+		dirs = []string{""}
+	} else {
+		if len(l.loading) > 0 {
+			dirs = append(dirs, filepath.Dir(l.loading[len(l.loading)-1]))
+		} else {
+			dirs = append(dirs, ".")
+		}
+		dirs = append(dirs, l.SearchPaths...)
+	}
+
+	candidates := []string{path}
+	if filepath.Ext(path) == "" {
+		candidates = append(candidates, path+SourceExt, path+BytecodeExt)
+	}
+
+	for _, dir := range dirs {
+		for _, candidate := range candidates {
+			full := filepath.Join(dir, candidate)
+			info, err := os.Stat(full)
+			if err != nil || info.IsDir() {
+				continue
+			}
+			return canonicalPath(full)
+		}
+	}
+	return "", fmt.Errorf("cannot find module %q", path)
+}
+
+// canonicalPath returns the absolute, symlink-free form of path used as the cache key.
+func canonicalPath(path string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	resolved, err := filepath.EvalSymlinks(abs)
+	if err != nil {
+		return "", err
+	}
+	return resolved, nil
+}
+
+// displayPath shortens a canonical path relative to the working directory for messages.
+func displayPath(path string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return path
+	}
+	rel, err := filepath.Rel(wd, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return path
+	}
+	return rel
+}
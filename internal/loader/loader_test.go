@@ -0,0 +1,58 @@
+package loader
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/SethGK/Inscript/internal/ast"
+	"github.com/SethGK/Inscript/internal/compiler"
+	"github.com/SethGK/Inscript/internal/vm"
+)
+
+// busy runs about 800 instructions.
+const busy = "n = 0\nwhile n < 100 { n = n + 1 }\n"
+
+func TestImportSharesInstructionBudget(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "busy.ins"), []byte(busy), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	mainFile := filepath.Join(dir, "main.ins")
+	limits := vm.Limits{MaxInstructions: 1000}
+
+	run := func(src string) error {
+		if err := os.WriteFile(mainFile, []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		program, err := ast.ParseFile(mainFile, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bytecode, err := compiler.New().Compile(program)
+		if err != nil {
+			t.Fatal(err)
+		}
+		importer := New(mainFile)
+		importer.Limits = limits
+		machine := vm.New(bytecode)
+		machine.SetImporter(importer)
+		machine.SetLimits(limits)
+		return machine.Run()
+	}
+
+	// Each half fits the budget on its own...
+	if err := run(busy); err != nil {
+		t.Fatalf("main alone: %v", err)
+	}
+	if err := run(`import "busy"`); err != nil {
+		t.Fatalf("import alone: %v", err)
+	}
+	// ...but not together.
+	err := run(`import "busy"` + "\n" + busy)
+	var limitErr *vm.InstructionLimitError
+	if !errors.As(err, &limitErr) {
+		t.Errorf("got %v, want an *InstructionLimitError", err)
+	}
+}
@@ -0,0 +1,662 @@
+// Package typecheck is an optional static pass between the AST builder and the
+// compiler. It infers the types of variables from the values assigned to them
+// and checks calls, returns and operators against the type annotations of
+// function parameters and results. A function with an annotated result must
+// return on every path. Anything it cannot infer has type any, which is
+// compatible with every annotation, so untyped code always passes.
+package typecheck
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+
+	"github.com/SethGK/Inscript/internal/ast"
+	"github.com/SethGK/Inscript/internal/types"
+)
+
+// lambdaName names function literals in messages, as the compiler does.
+const lambdaName = "<lambda>"
+
+// Error is a type error found in a program.
+type Error struct {
+	Pos     types.Position
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Pos.String() + ": " + e.Message
+}
+
+// Errors is the list of type errors found in a program, in source order.
+type Errors []*Error
+
+func (errs Errors) Error() string {
+	parts := make([]string, len(errs))
+	for i, e := range errs {
+		parts[i] = e.Error()
+	}
+	return strings.Join(parts, "\n")
+}
+
+// builtinResults holds the result types of the builtin functions.
+var builtinResults = map[string]Type{
+	"len":   intType,
+	"type":  stringType,
+	"str":   stringType,
+	"int":   intType,
+	"float": floatType,
+	"push":  listType,
+	"keys":  listType,
+	"range": listType,
+	"error": anyType,
+}
+
+// Check type checks program. It returns Errors if any were found.
+func Check(program *ast.Program) error {
+	c := &checker{file: program.File, env: newEnv(nil)}
+	c.stmts(program.Stmts)
+	if len(c.errors) > 0 {
+		return c.errors
+	}
+	return nil
+}
+
+// env holds the types of the variables of a function, or of the module's
+// globals. As in the compiler, blocks do not introduce scopes.
+type env struct {
+	vars  map[string]Type
+	outer *env
+}
+
+func newEnv(outer *env) *env {
+	return &env{vars: make(map[string]Type), outer: outer}
+}
+
+type checker struct {
+	file   *ast.File
+	env    *env
+	sig    *Signature // Signature of the function being checked; nil at top level
+	errors Errors
+	muted  int // While positive, errors are discarded
+}
+
+func (c *checker) errorf(pos token.Pos, format string, a ...interface{}) {
+	if c.muted > 0 {
+		return
+	}
+	var p types.Position
+	if c.file != nil {
+		fp := c.file.Position(pos)
+		p = types.Position{File: fp.Filename, Line: fp.Line, Column: fp.Column}
+	}
+	c.errors = append(c.errors, &Error{Pos: p, Message: fmt.Sprintf(format, a...)})
+}
+
+// lookup returns the type of a variable. Variables of enclosing functions may
+// be reassigned after a closure is created, so only their function signatures
+// are trusted.
+func (c *checker) lookup(name string) (Type, bool) {
+	for e := c.env; e != nil; e = e.outer {
+		if t, ok := e.vars[name]; ok {
+			if e != c.env && t.Kind != Function {
+				return anyType, true
+			}
+			return t, true
+		}
+	}
+	return anyType, false
+}
+
+// assign records that t is assigned to name. Like the compiler, an assignment
+// updates an existing variable of an enclosing function before it defines a
+// new one. Such a variable may then hold either type.
+func (c *checker) assign(name string, t Type) {
+	if _, local := c.env.vars[name]; !local {
+		for e := c.env.outer; e != nil; e = e.outer {
+			if old, ok := e.vars[name]; ok {
+				e.vars[name] = join(old, t)
+				return
+			}
+		}
+	}
+	c.env.vars[name] = t
+}
+
+// snapshot copies the variables of the current function.
+func (c *checker) snapshot() map[string]Type {
+	vars := make(map[string]Type, len(c.env.vars))
+	for k, v := range c.env.vars {
+		vars[k] = v
+	}
+	return vars
+}
+
+// merge joins the variables of several paths that meet. A variable missing
+// from one of the paths may be unset, so it has type any.
+func merge(paths ...map[string]Type) map[string]Type {
+	out := make(map[string]Type)
+	for _, vars := range paths {
+		for name := range vars {
+			if _, done := out[name]; done {
+				continue
+			}
+			t, first := vars[name], true
+			for _, other := range paths {
+				u, ok := other[name]
+				switch {
+				case !ok:
+					t = anyType
+				case first:
+					first = false
+				default:
+					t = join(t, u)
+				}
+			}
+			out[name] = t
+		}
+	}
+	return out
+}
+
+func equal(a, b map[string]Type) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
+
+// branches checks alternative paths from the current state and merges their
+// results. With optional set, none of the paths may run.
+func (c *checker) branches(optional bool, paths ...func()) {
+	before := c.snapshot()
+	var results []map[string]Type
+	if optional {
+		results = append(results, before)
+	}
+	for _, path := range paths {
+		c.env.vars = merge(before)
+		path()
+		results = append(results, c.env.vars)
+	}
+	c.env.vars = merge(results...)
+}
+
+// loop checks a loop body. Types flowing around the loop are found first with
+// errors muted, so that the body is checked once with the types of any
+// iteration.
+func (c *checker) loop(body func()) {
+	c.muted++
+	for {
+		before := c.snapshot()
+		body()
+		after := merge(before, c.env.vars)
+		c.env.vars = after
+		if equal(before, after) {
+			break
+		}
+	}
+	c.muted--
+	before := c.snapshot()
+	body()
+	c.env.vars = merge(before, c.env.vars)
+}
+
+func (c *checker) stmts(stmts []ast.Statement) {
+	for _, s := range stmts {
+		c.stmt(s)
+	}
+}
+
+func (c *checker) block(b *ast.BlockStmt) {
+	if b != nil {
+		c.stmts(b.Stmts)
+	}
+}
+
+func (c *checker) stmt(s ast.Statement) {
+	switch s := s.(type) {
+	case *ast.ExprStmt:
+		c.expr(s.Expr)
+	case *ast.AssignStmt:
+		c.assignStmt(s)
+	case *ast.BlockStmt:
+		c.block(s)
+	case *ast.IfStmt:
+		c.expr(s.Cond)
+		paths := []func(){func() { c.block(s.Then) }}
+		for _, elif := range s.ElseIfs {
+			elif := elif
+			paths = append(paths, func() {
+				c.expr(elif.Cond)
+				c.block(elif.Body)
+			})
+		}
+		if s.Else != nil {
+			paths = append(paths, func() { c.block(s.Else) })
+		}
+		c.branches(s.Else == nil, paths...)
+	case *ast.WhileStmt:
+		c.loop(func() {
+			c.expr(s.Cond)
+			c.block(s.Body)
+		})
+	case *ast.ForStmt:
+		elem := anyType
+		if c.expr(s.Iterable).Kind == String {
+			elem = stringType
+		}
+		c.loop(func() {
+			c.target(s.Target, elem)
+			c.block(s.Body)
+		})
+	case *ast.FunctionDef:
+		sig := c.signature(s.Name, s.Params, s.ReturnType)
+		c.assign(s.Name, Type{Kind: Function, Sig: sig})
+		c.function(sig, s.Params, s.ReturnType, s.Body)
+	case *ast.ReturnStmt:
+		t := nilType
+		if s.Expr != nil {
+			t = c.expr(s.Expr)
+		}
+		if c.sig != nil && !t.assignableTo(c.sig.Result) {
+			c.errorf(s.Pos(), "cannot return %s from %s(), which returns %s", t, c.sig.Name, c.sig.Result)
+		}
+	case *ast.PrintStmt:
+		for _, e := range s.Exprs {
+			c.expr(e)
+		}
+	case *ast.TryStmt:
+		paths := []func(){func() { c.block(s.Body) }}
+		if s.Catch != nil {
+			paths = append(paths, func() {
+				c.assign(s.CatchVar, anyType)
+				c.block(s.Catch)
+			})
+		}
+		c.branches(true, paths...)
+		c.block(s.Finally)
+	case *ast.ThrowStmt:
+		c.expr(s.Expr)
+	case *ast.ImportStmt, *ast.BreakStmt, *ast.ContinueStmt:
+	}
+}
+
+func (c *checker) assignStmt(s *ast.AssignStmt) {
+	// a, b = x, y assigns each target the type of its own value.
+	if tuple, ok := s.Target.(*ast.TupleTarget); ok {
+		if values, ok := s.Value.(*ast.TupleLiteral); ok && len(values.Elements) == len(tuple.Elements) && !hasRest(tuple) {
+			valueTypes := make([]Type, len(values.Elements))
+			for i, v := range values.Elements {
+				valueTypes[i] = c.expr(v)
+			}
+			for i, el := range tuple.Elements {
+				c.target(el, valueTypes[i])
+			}
+			return
+		}
+	}
+
+	value := c.expr(s.Value)
+	if ident, ok := s.Target.(*ast.Identifier); ok {
+		if op := strings.TrimSuffix(s.Op.Literal, "="); op != "" {
+			current, _ := c.lookup(ident.Name)
+			value = c.binary(s.Op.Pos, op, current, value)
+		}
+	}
+	c.target(s.Target, value)
+}
+
+// target records an assignment of t to the target of an assignment or for
+// loop. The values a pattern takes apart are unknown, so its names get any.
+func (c *checker) target(e ast.Expression, t Type) {
+	switch target := e.(type) {
+	case *ast.Identifier:
+		c.assign(target.Name, t)
+	case *ast.RestTarget:
+		c.assign(target.Name, listType)
+	case *ast.TupleTarget:
+		for _, el := range target.Elements {
+			c.target(el, anyType)
+		}
+	case *ast.IndexExpr:
+		if c.expr(target.Primary).Kind == Tuple {
+			c.errorf(target.Pos(), "cannot assign to an element of a tuple")
+		}
+		c.expr(target.Index)
+	case *ast.AttrExpr:
+		c.expr(target.Primary)
+	}
+}
+
+// hasRest reports whether a pattern has a ...rest element.
+func hasRest(t *ast.TupleTarget) bool {
+	for _, el := range t.Elements {
+		if _, ok := el.(*ast.RestTarget); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// signature builds the signature of a function from its annotations.
+func (c *checker) signature(name string, params []ast.Param, result *ast.TypeAnnotation) *Signature {
+	sig := &Signature{Name: name}
+	for _, p := range params {
+		param := Param{Name: p.Name, Type: c.annotation(p.Type), HasDefault: p.DefaultValue != nil, Variadic: p.IsVariadic}
+		if p.IsVariadic {
+			param.Type = listType
+		}
+		sig.Params = append(sig.Params, param)
+	}
+	sig.Result = c.annotation(result) // After the parameters, to report errors in source order
+	return sig
+}
+
+// annotation resolves a type annotation; a missing one means any.
+func (c *checker) annotation(a *ast.TypeAnnotation) Type {
+	if a == nil {
+		return anyType
+	}
+	kind, ok := lookupKind(a.Name)
+	if !ok {
+		c.errorf(a.PosToken, "unknown type '%s'", a.Name)
+	}
+	return Type{Kind: kind}
+}
+
+// function checks the defaults and body of a function in a new environment.
+// A function whose result is annotated must not run off the end of its body,
+// since it would return nil.
+func (c *checker) function(sig *Signature, params []ast.Param, result *ast.TypeAnnotation, body *ast.BlockStmt) {
+	outerEnv, outerSig := c.env, c.sig
+	c.env, c.sig = newEnv(outerEnv), sig
+	for i, p := range params {
+		if p.DefaultValue != nil {
+			if t := c.expr(p.DefaultValue); !t.assignableTo(sig.Params[i].Type) {
+				c.errorf(p.DefaultValue.Pos(), "default value of '%s' must be %s, not %s", p.Name, sig.Params[i].Type, t)
+			}
+		}
+		c.env.vars[p.Name] = sig.Params[i].Type
+	}
+	c.block(body)
+	if sig.Result.Kind != Any && !terminates(body) {
+		c.errorf(result.PosToken, "missing return at the end of %s(), which returns %s", sig.Name, sig.Result)
+	}
+	c.env, c.sig = outerEnv, outerSig
+}
+
+// terminates reports whether a block never finishes normally: on every path
+// it returns, throws or loops forever.
+func terminates(b *ast.BlockStmt) bool {
+	if b == nil || len(b.Stmts) == 0 {
+		return false
+	}
+	switch s := b.Stmts[len(b.Stmts)-1].(type) {
+	case *ast.ReturnStmt, *ast.ThrowStmt:
+		return true
+	case *ast.BlockStmt:
+		return terminates(s)
+	case *ast.IfStmt:
+		if s.Else == nil || !terminates(s.Then) || !terminates(s.Else) {
+			return false
+		}
+		for _, elif := range s.ElseIfs {
+			if !terminates(elif.Body) {
+				return false
+			}
+		}
+		return true
+	case *ast.TryStmt:
+		if terminates(s.Finally) {
+			return true
+		}
+		return terminates(s.Body) && (s.Catch == nil || terminates(s.Catch))
+	case *ast.WhileStmt:
+		cond, ok := s.Cond.(*ast.BooleanLiteral)
+		return ok && cond.Value && !breaks(s.Body)
+	}
+	return false
+}
+
+// breaks reports whether a loop body contains a break that leaves the loop,
+// rather than a loop nested in it.
+func breaks(b *ast.BlockStmt) bool {
+	if b == nil {
+		return false
+	}
+	for _, stmt := range b.Stmts {
+		switch s := stmt.(type) {
+		case *ast.BreakStmt:
+			return true
+		case *ast.BlockStmt:
+			if breaks(s) {
+				return true
+			}
+		case *ast.IfStmt:
+			if breaks(s.Then) || breaks(s.Else) {
+				return true
+			}
+			for _, elif := range s.ElseIfs {
+				if breaks(elif.Body) {
+					return true
+				}
+			}
+		case *ast.TryStmt:
+			if breaks(s.Body) || breaks(s.Catch) || breaks(s.Finally) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c *checker) expr(e ast.Expression) Type {
+	switch e := e.(type) {
+	case *ast.IntegerLiteral:
+		return intType
+	case *ast.FloatLiteral:
+		return floatType
+	case *ast.StringLiteral:
+		return stringType
+	case *ast.BooleanLiteral:
+		return boolType
+	case *ast.NilLiteral:
+		return nilType
+	case *ast.Identifier:
+		if t, ok := c.lookup(e.Name); ok {
+			return t
+		}
+		if _, ok := builtinResults[e.Name]; ok {
+			return funcType
+		}
+		return anyType
+	case *ast.ListLiteral:
+		for _, el := range e.Elements {
+			c.expr(el)
+		}
+		return listType
+	case *ast.TableLiteral:
+		for _, f := range e.Fields {
+			if _, named := f.Key.(*ast.Identifier); !named {
+				c.expr(f.Key)
+			}
+			c.expr(f.Value)
+		}
+		return tableType
+	case *ast.TupleLiteral:
+		for _, el := range e.Elements {
+			c.expr(el)
+		}
+		return tupleType
+	case *ast.FunctionLiteral:
+		sig := c.signature(lambdaName, e.Params, e.ReturnType)
+		c.function(sig, e.Params, e.ReturnType, e.Body)
+		return Type{Kind: Function, Sig: sig}
+	case *ast.UnaryExpr:
+		return c.unary(e)
+	case *ast.BinaryExpr:
+		left, right := c.expr(e.Left), c.expr(e.Right)
+		return c.binary(e.Operator.Pos, e.Operator.Literal, left, right)
+	case *ast.CallExpr:
+		return c.call(e)
+	case *ast.IndexExpr:
+		c.expr(e.Primary)
+		c.expr(e.Index)
+		return anyType
+	case *ast.AttrExpr:
+		c.expr(e.Primary)
+		return anyType
+	case *ast.KeywordArg:
+		c.expr(e.Value)
+		return anyType
+	}
+	return anyType
+}
+
+func (c *checker) unary(e *ast.UnaryExpr) Type {
+	t := c.expr(e.Expr)
+	switch e.Operator.Literal {
+	case "not":
+		return boolType
+	case "-":
+		if t.Kind == Int || t.Kind == Float || t.Kind == Any {
+			return Type{Kind: t.Kind}
+		}
+	case "~":
+		if t.Kind == Int || t.Kind == Any {
+			return intType
+		}
+	default:
+		return anyType
+	}
+	c.errorf(e.Pos(), "invalid operation: %s%s", e.Operator.Literal, t)
+	return anyType
+}
+
+func isNumeric(t Type) bool { return t.Kind == Int || t.Kind == Float }
+
+// binary returns the result type of an operator, reporting operands that the
+// VM would reject.
+func (c *checker) binary(pos token.Pos, op string, left, right Type) Type {
+	switch op {
+	case "and", "or":
+		return join(left, right)
+	case "==", "!=":
+		return boolType
+	case "<", "<=", ">", ">=":
+		if left.Kind == Any || right.Kind == Any || (isNumeric(left) && isNumeric(right)) || (left.Kind == String && right.Kind == String) || (left.Kind == Tuple && right.Kind == Tuple) {
+			return boolType
+		}
+	case "&", "|", "^", "<<", ">>":
+		if left.assignableTo(intType) && right.assignableTo(intType) && left.Kind != Float && right.Kind != Float {
+			return intType
+		}
+	case "+", "-", "*", "/", "%", "^^", "//":
+		if left.Kind == Any || right.Kind == Any {
+			if op == "/" || op == "^^" {
+				return floatType
+			}
+			return anyType
+		}
+		if op == "+" && left.Kind == right.Kind && (left.Kind == String || left.Kind == List || left.Kind == Tuple) {
+			return Type{Kind: left.Kind}
+		}
+		if isNumeric(left) && isNumeric(right) {
+			switch {
+			case op == "/" || op == "^^":
+				return floatType
+			case op == "//":
+				return intType
+			case left.Kind == Int && right.Kind == Int:
+				return intType
+			}
+			return floatType
+		}
+	default:
+		return anyType
+	}
+	c.errorf(pos, "invalid operation: %s %s %s", left, op, right)
+	return anyType
+}
+
+// call checks the arguments of a call against the callee's signature, when it
+// is known, and returns the type of the result.
+func (c *checker) call(e *ast.CallExpr) Type {
+	callee := c.expr(e.Callee)
+	args := make([]Type, len(e.Args))
+	for i, arg := range e.Args {
+		if kw, ok := arg.(*ast.KeywordArg); ok {
+			args[i] = c.expr(kw.Value)
+		} else {
+			args[i] = c.expr(arg)
+		}
+	}
+
+	if ident, ok := e.Callee.(*ast.Identifier); ok {
+		if _, defined := c.lookup(ident.Name); !defined {
+			if result, ok := builtinResults[ident.Name]; ok {
+				return result
+			}
+		}
+	}
+	if callee.Kind != Function {
+		if callee.Kind != Any {
+			c.errorf(e.Pos(), "cannot call a value of type %s", callee)
+		}
+		return anyType
+	}
+	sig := callee.Sig
+	if sig == nil {
+		return anyType
+	}
+
+	fixed := sig.fixed()
+	bound := make([]bool, len(fixed))
+	positional := 0
+	for i, arg := range e.Args {
+		if kw, ok := arg.(*ast.KeywordArg); ok {
+			slot := -1
+			for j, p := range fixed {
+				if p.Name == kw.Name {
+					slot = j
+				}
+			}
+			switch {
+			case slot < 0:
+				c.errorf(kw.Pos(), "%s() has no parameter '%s'", sig.Name, kw.Name)
+			case bound[slot]:
+				c.errorf(kw.Pos(), "%s() got multiple values for argument '%s'", sig.Name, kw.Name)
+			default:
+				bound[slot] = true
+				c.checkArg(sig, fixed[slot], args[i], kw.Value.Pos())
+			}
+			continue
+		}
+		if positional < len(fixed) {
+			bound[positional] = true
+			c.checkArg(sig, fixed[positional], args[i], arg.Pos())
+		} else if len(fixed) == len(sig.Params) {
+			c.errorf(arg.Pos(), "too many arguments in call to %s(): takes %d", sig.Name, len(fixed))
+			break
+		}
+		positional++
+	}
+	for i, p := range fixed {
+		if !bound[i] && !p.HasDefault {
+			c.errorf(e.Pos(), "missing argument '%s' in call to %s()", p.Name, sig.Name)
+		}
+	}
+	return sig.Result
+}
+
+func (c *checker) checkArg(sig *Signature, p Param, t Type, pos token.Pos) {
+	if !t.assignableTo(p.Type) {
+		c.errorf(pos, "argument '%s' of %s() must be %s, not %s", p.Name, sig.Name, p.Type, t)
+	}
+}
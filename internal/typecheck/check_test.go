@@ -0,0 +1,243 @@
+package typecheck
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/SethGK/Inscript/internal/ast"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		errs []string // Expected errors, as "line:col: message"; calls and indexing are placed at their bracket
+	}{
+		{
+			name: "untyped code",
+			src: `
+x = 1
+x = x + 2.5
+function f(a, b) { return a + b }
+print(f("a", "b"), f(1, 2))
+`,
+		},
+		{
+			name: "each annotation kind",
+			src: `
+function f(a: int, b: float, c: string, d: bool, e: list, g: tuple, h: table, m: any) { }
+f(nil, "x", 1, 1, 1, 1, 1, 1)
+f(1, 1.5, "s", true, [], (1, 2), {}, nil)
+`,
+			errs: []string{
+				"3:3: argument 'a' of f() must be int, not nil",
+				"3:8: argument 'b' of f() must be float, not string",
+				"3:13: argument 'c' of f() must be string, not int",
+				"3:16: argument 'd' of f() must be bool, not int",
+				"3:19: argument 'e' of f() must be list, not int",
+				"3:22: argument 'g' of f() must be tuple, not int",
+				"3:25: argument 'h' of f() must be table, not int",
+			},
+		},
+		{
+			name: "unknown annotation",
+			src: `
+function f(a: number) -> text { }
+`,
+			errs: []string{
+				"2:15: unknown type 'number'",
+				"2:26: unknown type 'text'",
+			},
+		},
+		{
+			name: "call site arity and keywords",
+			src: `
+function f(a: int, b = "b": string) { }
+f()
+f(1, "x", 3)
+f(1, c = 2)
+f(1, a = 2)
+f(1, b = 2)
+`,
+			errs: []string{
+				"3:2: missing argument 'a' in call to f()",
+				"4:11: too many arguments in call to f(): takes 2",
+				"5:6: f() has no parameter 'c'",
+				"6:6: f() got multiple values for argument 'a'",
+				"7:10: argument 'b' of f() must be string, not int",
+			},
+		},
+		{
+			name: "default values",
+			src: `
+function f(a = 1: string, b = 2: float) { }
+`,
+			errs: []string{
+				"2:16: default value of 'a' must be string, not int",
+			},
+		},
+		{
+			name: "variadic",
+			src: `
+function f(a: int, ...rest) { }
+f(1, "x", nil)
+f("x")
+`,
+			errs: []string{
+				"4:3: argument 'a' of f() must be int, not string",
+			},
+		},
+		{
+			name: "returns",
+			src: `
+function f() -> int { return "s" }
+function g() -> string { return }
+function h() -> float { return 1 }
+k = function () -> bool { return 1 }
+`,
+			errs: []string{
+				"2:23: cannot return string from f(), which returns int",
+				"3:26: cannot return nil from g(), which returns string",
+				"5:27: cannot return int from <lambda>(), which returns bool",
+			},
+		},
+		{
+			name: "int widens to float",
+			src: `
+function half(x: float) -> float { return x / 2 }
+n = 3
+print(half(n), half(1.5))
+`,
+		},
+		{
+			name: "result types flow to callers",
+			src: `
+function name() -> string { return "x" }
+function count(n: int) -> int { return n }
+count(name())
+`,
+			errs: []string{
+				"4:11: argument 'n' of count() must be int, not string",
+			},
+		},
+		{
+			// A variable that holds different types on different paths has type
+			// any, which every annotation accepts.
+			name: "join to any",
+			src: `
+function f(x: int) { }
+if true { v = 1 } else { v = "s" }
+f(v)
+w = 1
+if false { w = "s" }
+f(w)
+u = 1
+if false { u = 2 }
+f(u)
+`,
+		},
+		{
+			name: "join of the same type",
+			src: `
+function f(x: int) { }
+if true { v = "a" } else { v = "b" }
+f(v)
+`,
+			errs: []string{
+				"4:3: argument 'x' of f() must be int, not string",
+			},
+		},
+		{
+			name: "loops",
+			src: `
+function f(x: int) { }
+v = 1
+for i in range(3) { f(v) v = "s" }
+s = "x"
+for c in s { f(c) }
+`,
+			errs: []string{
+				"6:16: argument 'x' of f() must be int, not string",
+			},
+		},
+		{
+			name: "operators",
+			src: `
+x = 1 + "s"
+y = -"s"
+z = 1.5 & 2
+ok = [1] + [2]
+cmp = "a" < 1
+`,
+			errs: []string{
+				"2:7: invalid operation: int + string",
+				"3:5: invalid operation: -string",
+				"4:9: invalid operation: float & int",
+				"6:11: invalid operation: string < int",
+			},
+		},
+		{
+			name: "calls of values that are not functions",
+			src: `
+x = 1
+x()
+t = (1, 2)
+t[0] = 3
+`,
+			errs: []string{
+				"3:2: cannot call a value of type int",
+				"5:2: cannot assign to an element of a tuple",
+			},
+		},
+		{
+			name: "missing return",
+			src: `
+function a(x: int) -> int { if x > 0 { return 1 } }
+function b(x: int) -> int { if x > 0 { return 1 } else if x < 0 { return -1 } }
+function c() -> int { while true { break } }
+function d() -> int { }
+e = function () -> int { print(1) }
+`,
+			errs: []string{
+				"2:23: missing return at the end of a(), which returns int",
+				"3:23: missing return at the end of b(), which returns int",
+				"4:17: missing return at the end of c(), which returns int",
+				"5:17: missing return at the end of d(), which returns int",
+				"6:20: missing return at the end of <lambda>(), which returns int",
+			},
+		},
+		{
+			name: "every path returns",
+			src: `
+function a(x: int) -> int { if x > 0 { return 1 } else if x < 0 { return -1 } else { return 0 } }
+function b() -> int { throw "unreachable" }
+function c() -> int { while true { for i in range(3) { break } } }
+function d() -> int { try { return 1 } catch e { return 2 } }
+function e() -> int { try { print(1) } finally { return 3 } }
+function f() { }
+function g() -> any { }
+h = (x) -> x
+`,
+		},
+	}
+	for _, tt := range tests {
+		program, err := ast.ParseFile("test.ins", tt.src)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		var got []string
+		if err := Check(program); err != nil {
+			var errs Errors
+			if !errors.As(err, &errs) {
+				t.Fatalf("%s: Check returned %T, want Errors", tt.name, err)
+			}
+			for _, e := range errs {
+				got = append(got, strings.TrimPrefix(e.Error(), "test.ins:"))
+			}
+		}
+		if strings.Join(got, "\n") != strings.Join(tt.errs, "\n") {
+			t.Errorf("%s: got errors\n\t%s\nwant\n\t%s", tt.name, strings.Join(got, "\n\t"), strings.Join(tt.errs, "\n\t"))
+		}
+	}
+}
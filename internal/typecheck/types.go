@@ -0,0 +1,111 @@
+package typecheck
+
+// Kind is the kind of a static type.
+type Kind int
+
+const (
+	Any Kind = iota // Unknown or mixed; compatible with every type
+	Nil
+	Int
+	Float
+	String
+	Bool
+	List
+	Tuple
+	Table
+	Function
+)
+
+// kindNames holds the names used in type annotations, indexed by Kind.
+var kindNames = [...]string{
+	Any:      "any",
+	Nil:      "nil",
+	Int:      "int",
+	Float:    "float",
+	String:   "string",
+	Bool:     "bool",
+	List:     "list",
+	Tuple:    "tuple",
+	Table:    "table",
+	Function: "function",
+}
+
+func (k Kind) String() string { return kindNames[k] }
+
+// Type is the static type of a value. Function types carry the signature of
+// the function when it is known.
+type Type struct {
+	Kind Kind
+	Sig  *Signature
+}
+
+var (
+	anyType    = Type{Kind: Any}
+	nilType    = Type{Kind: Nil}
+	intType    = Type{Kind: Int}
+	floatType  = Type{Kind: Float}
+	stringType = Type{Kind: String}
+	boolType   = Type{Kind: Bool}
+	listType   = Type{Kind: List}
+	tupleType  = Type{Kind: Tuple}
+	tableType  = Type{Kind: Table}
+	funcType   = Type{Kind: Function}
+)
+
+func (t Type) String() string { return t.Kind.String() }
+
+// assignableTo reports whether a value of type t may be used where want is
+// expected. An int is accepted where a float is expected.
+func (t Type) assignableTo(want Type) bool {
+	switch {
+	case t.Kind == Any || want.Kind == Any:
+		return true
+	case t.Kind == Int && want.Kind == Float:
+		return true
+	}
+	return t.Kind == want.Kind
+}
+
+// join is the type of a variable that holds either t or u.
+func join(t, u Type) Type {
+	if t.Kind != u.Kind {
+		return anyType
+	}
+	if t.Sig != u.Sig {
+		return Type{Kind: t.Kind}
+	}
+	return t
+}
+
+// Param is a parameter of a signature.
+type Param struct {
+	Name       string
+	Type       Type
+	HasDefault bool
+	Variadic   bool
+}
+
+// Signature describes the parameters and result of a function.
+type Signature struct {
+	Name   string
+	Params []Param
+	Result Type
+}
+
+// fixed returns the parameters that are not variadic.
+func (s *Signature) fixed() []Param {
+	if n := len(s.Params); n > 0 && s.Params[n-1].Variadic {
+		return s.Params[:n-1]
+	}
+	return s.Params
+}
+
+// lookupKind resolves a type annotation name.
+func lookupKind(name string) (Kind, bool) {
+	for k, n := range kindNames {
+		if n == name && Kind(k) != Nil {
+			return Kind(k), true
+		}
+	}
+	return Any, false
+}
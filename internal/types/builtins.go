@@ -0,0 +1,261 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Builtins lists the native functions available to every script. The compiler
+// registers each name in the global symbol table under its index in this slice,
+// and the VM resolves OpGetBuiltin operands against the same slice, so entries
+// must only ever be appended.
+var Builtins = []struct {
+	Name    string
+	Builtin *Builtin
+}{
+	{"len", &Builtin{Name: "len", Fn: builtinLen}},
+	{"type", &Builtin{Name: "type", Fn: builtinType}},
+	{"str", &Builtin{Name: "str", Fn: builtinStr}},
+	{"int", &Builtin{Name: "int", Fn: builtinInt}},
+	{"float", &Builtin{Name: "float", Fn: builtinFloat}},
+	{"push", &Builtin{Name: "push", Fn: builtinPush}},
+	{"keys", &Builtin{Name: "keys", Fn: builtinKeys}},
+	{"range", &Builtin{Name: "range", Sized: builtinRange}},
+	{"error", &Builtin{Name: "error", Fn: builtinError}},
+}
+
+// GetBuiltinByName returns the builtin registered under name, or nil.
+func GetBuiltinByName(name string) *Builtin {
+	for _, def := range Builtins {
+		if def.Name == name {
+			return def.Builtin
+		}
+	}
+	return nil
+}
+
+// AnnotationTypes lists the type names that may appear in annotations.
+var AnnotationTypes = []string{"int", "float", "string", "bool", "list", "tuple", "table", "function", "any"}
+
+// Conforms reports whether v satisfies the type annotation name. An empty name
+// or "any" accepts every value, and an int is accepted where a float is expected.
+func Conforms(v Value, name string) bool {
+	switch got := TypeName(v); {
+	case name == "" || name == "any" || got == name:
+		return true
+	default:
+		return name == "float" && got == "int"
+	}
+}
+
+// TypeName returns the script-facing name of a value's type, as reported by type().
+func TypeName(v Value) string {
+	switch v.Type() {
+	case INTEGER_OBJ:
+		return "int"
+	case FLOAT_OBJ:
+		return "float"
+	case STRING_OBJ:
+		return "string"
+	case BOOLEAN_OBJ:
+		return "bool"
+	case NULL_OBJ:
+		return "nil"
+	case LIST_OBJ:
+		return "list"
+	case TUPLE_OBJ:
+		return "tuple"
+	case TABLE_OBJ:
+		return "table"
+	case FUNCTION_OBJ, CLOSURE_OBJ, BUILTIN_OBJ:
+		return "function"
+	case ITERATOR_OBJ:
+		return "iterator"
+	case ERROR_OBJ:
+		return "error"
+	default:
+		return strings.ToLower(string(v.Type()))
+	}
+}
+
+// checkArgs validates the number of arguments passed to a builtin.
+func checkArgs(args []Value, min, max int) error {
+	if len(args) < min || len(args) > max {
+		if min == max {
+			return fmt.Errorf("wrong number of arguments: expected %d, got %d", min, len(args))
+		}
+		return fmt.Errorf("wrong number of arguments: expected %d to %d, got %d", min, max, len(args))
+	}
+	return nil
+}
+
+func builtinLen(args ...Value) (Value, error) {
+	if err := checkArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+	switch arg := args[0].(type) {
+	case *String:
+		return NewInteger(int64(len(arg.Value))), nil
+	case *List:
+		return NewInteger(int64(len(arg.Elements))), nil
+	case *Tuple:
+		return NewInteger(int64(len(arg.Elements))), nil
+	case *Table:
+		return NewInteger(int64(len(arg.Pairs))), nil
+	default:
+		return nil, fmt.Errorf("argument to len not supported, got %s", TypeName(arg))
+	}
+}
+
+func builtinType(args ...Value) (Value, error) {
+	if err := checkArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+	return NewString(TypeName(args[0])), nil
+}
+
+func builtinStr(args ...Value) (Value, error) {
+	if err := checkArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+	return NewString(args[0].Inspect()), nil
+}
+
+func builtinInt(args ...Value) (Value, error) {
+	if err := checkArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+	switch arg := args[0].(type) {
+	case *Integer:
+		return arg, nil
+	case *Float:
+		return NewInteger(int64(arg.Value)), nil
+	case *Boolean:
+		if arg.Value {
+			return NewInteger(1), nil
+		}
+		return NewInteger(0), nil
+	case *String:
+		i, err := strconv.ParseInt(strings.TrimSpace(arg.Value), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert %q to int", arg.Value)
+		}
+		return NewInteger(i), nil
+	default:
+		return nil, fmt.Errorf("cannot convert %s to int", TypeName(arg))
+	}
+}
+
+func builtinFloat(args ...Value) (Value, error) {
+	if err := checkArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+	switch arg := args[0].(type) {
+	case *Float:
+		return arg, nil
+	case *Integer:
+		return NewFloat(float64(arg.Value)), nil
+	case *String:
+		f, err := strconv.ParseFloat(strings.TrimSpace(arg.Value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert %q to float", arg.Value)
+		}
+		return NewFloat(f), nil
+	default:
+		return nil, fmt.Errorf("cannot convert %s to float", TypeName(arg))
+	}
+}
+
+// builtinPush appends values to a list in place and returns the list.
+func builtinPush(args ...Value) (Value, error) {
+	if len(args) < 2 {
+		return nil, fmt.Errorf("wrong number of arguments: expected at least 2, got %d", len(args))
+	}
+	list, ok := args[0].(*List)
+	if !ok {
+		return nil, fmt.Errorf("first argument to push must be a list, got %s", TypeName(args[0]))
+	}
+	list.Elements = append(list.Elements, args[1:]...)
+	return list, nil
+}
+
+// builtinKeys returns a table's keys in insertion order.
+func builtinKeys(args ...Value) (Value, error) {
+	if err := checkArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+	table, ok := args[0].(*Table)
+	if !ok {
+		return nil, fmt.Errorf("argument to keys must be a table, got %s", TypeName(args[0]))
+	}
+	keys := make([]Value, len(table.Pairs))
+	for i, pair := range table.Pairs {
+		keys[i] = pair.Key
+	}
+	return NewList(keys...), nil
+}
+
+// builtinRange returns the list of integers range(stop), range(start, stop)
+// or range(start, stop, step). It counts them first, so that a range over
+// the size limit fails without being built.
+func builtinRange(maxSize int, args ...Value) (Value, error) {
+	if err := checkArgs(args, 1, 3); err != nil {
+		return nil, err
+	}
+	bounds := make([]int64, len(args))
+	for i, arg := range args {
+		n, ok := arg.(*Integer)
+		if !ok {
+			return nil, fmt.Errorf("arguments to range must be integers, got %s", TypeName(arg))
+		}
+		bounds[i] = n.Value
+	}
+
+	start, stop, step := int64(0), bounds[0], int64(1)
+	if len(bounds) > 1 {
+		start, stop = bounds[0], bounds[1]
+	}
+	if len(bounds) > 2 {
+		step = bounds[2]
+	}
+	if step == 0 {
+		return nil, fmt.Errorf("range step must not be zero")
+	}
+	if maxSize > 0 {
+		if n := rangeLen(start, stop, step); n > uint64(maxSize) {
+			size := math.MaxInt
+			if n < math.MaxInt {
+				size = int(n)
+			}
+			return nil, &SizeLimitError{Kind: LIST_OBJ, Size: size, Limit: maxSize}
+		}
+	}
+
+	var elements []Value
+	for i := start; (step > 0 && i < stop) || (step < 0 && i > stop); i += step {
+		elements = append(elements, NewInteger(i))
+	}
+	return NewList(elements...), nil
+}
+
+// rangeLen returns the number of integers in range(start, stop, step). The
+// differences are taken in uint64, where they cannot overflow.
+func rangeLen(start, stop, step int64) uint64 {
+	switch {
+	case step > 0 && start < stop:
+		return (uint64(stop)-uint64(start)-1)/uint64(step) + 1
+	case step < 0 && start > stop:
+		return (uint64(start)-uint64(stop)-1)/(uint64(-(step+1))+1) + 1
+	}
+	return 0
+}
+
+// builtinError creates an Error value carrying the given message.
+func builtinError(args ...Value) (Value, error) {
+	if err := checkArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+	return &Error{Message: args[0].Inspect()}, nil
+}
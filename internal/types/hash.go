@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// HashKey identifies a value used as a table key. Hashable values that are
+// Equal have the same HashKey and values that are not have different ones, so
+// tables compare keys by HashKey alone. Scalars keep their bits in Bits and
+// strings their contents in Str; composite keys encode their elements in Str.
+type HashKey struct {
+	Type Type
+	Bits uint64
+	Str  string
+}
+
+// Hashable is implemented by the values that can be table keys: integers,
+// floats, strings, booleans and tuples. Mutable values such as lists and
+// tables are not hashable, since changing them would lose their entry. A
+// tuple is hashable only if its elements are, so HashKey may fail.
+type Hashable interface {
+	Value
+	HashKey() (HashKey, error)
+}
+
+func (i *Integer) HashKey() (HashKey, error) {
+	return HashKey{Type: INTEGER_OBJ, Bits: uint64(i.Value)}, nil
+}
+
+func (f *Float) HashKey() (HashKey, error) {
+	v := f.Value
+	if v == 0 {
+		v = 0 // -0.0 equals 0.0, so it must hash the same
+	}
+	return HashKey{Type: FLOAT_OBJ, Bits: math.Float64bits(v)}, nil
+}
+
+func (s *String) HashKey() (HashKey, error) { return HashKey{Type: STRING_OBJ, Str: s.Value}, nil }
+
+func (b *Boolean) HashKey() (HashKey, error) {
+	if b.Value {
+		return HashKey{Type: BOOLEAN_OBJ, Bits: 1}, nil
+	}
+	return HashKey{Type: BOOLEAN_OBJ}, nil
+}
+
+// HashKey encodes the keys of the elements in order, each prefixed with its
+// type and the length of its string, so distinct tuples never share a key.
+func (t *Tuple) HashKey() (HashKey, error) {
+	var b strings.Builder
+	for _, el := range t.Elements {
+		k, err := HashKeyOf(el)
+		if err != nil {
+			return HashKey{}, err
+		}
+		b.WriteString(string(k.Type))
+		b.WriteByte(':')
+		b.WriteString(strconv.FormatUint(k.Bits, 10))
+		b.WriteByte(':')
+		b.WriteString(strconv.Itoa(len(k.Str)))
+		b.WriteByte(':')
+		b.WriteString(k.Str)
+	}
+	return HashKey{Type: TUPLE_OBJ, Bits: uint64(len(t.Elements)), Str: b.String()}, nil
+}
+
+// HashKeyOf returns the hash key of v, or an error if v cannot be a table key.
+func HashKeyOf(v Value) (HashKey, error) {
+	h, ok := v.(Hashable)
+	if !ok {
+		return HashKey{}, fmt.Errorf("unhashable type: %s", TypeName(v))
+	}
+	return h.HashKey()
+}
@@ -0,0 +1,377 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Method is a native method of a built-in type. It receives the value it was
+// looked up on followed by the call's arguments.
+type Method func(receiver Value, args ...Value) (Value, error)
+
+// Methods lists the native methods of each built-in type that has any. Tables
+// look up their own keys first, so a key shadows the method of the same name.
+var Methods = map[Type]map[string]Method{
+	LIST_OBJ: {
+		"push":    listPush,
+		"pop":     listPop,
+		"insert":  listInsert,
+		"remove":  listRemove,
+		"sort":    listSort,
+		"reverse": listReverse,
+		"index":   listIndex,
+	},
+	STRING_OBJ: {
+		"split":      stringSplit,
+		"join":       stringJoin,
+		"upper":      stringUpper,
+		"lower":      stringLower,
+		"strip":      stringStrip,
+		"find":       stringFind,
+		"replace":    stringReplace,
+		"startswith": stringStartsWith,
+	},
+	TABLE_OBJ: {
+		"keys":   tableKeys,
+		"values": tableValues,
+		"items":  tableItems,
+		"has":    tableHas,
+		"delete": tableDelete,
+	},
+}
+
+// GetMethod returns the method name of the receiver's type as a builtin bound
+// to the receiver. It reports false if the type has no such method.
+func GetMethod(receiver Value, name string) (*Builtin, bool) {
+	method, ok := Methods[receiver.Type()][name]
+	if !ok {
+		return nil, false
+	}
+	return &Builtin{
+		Name: TypeName(receiver) + "." + name,
+		Fn: func(args ...Value) (Value, error) {
+			return method(receiver, args...)
+		},
+		Receiver: receiver,
+	}, true
+}
+
+// index checks that v is an integer in [0, n) and returns it.
+func index(v Value, n int) (int, error) {
+	i, ok := v.(*Integer)
+	if !ok {
+		return 0, fmt.Errorf("index must be an integer, got %s", TypeName(v))
+	}
+	if i.Value < 0 || i.Value >= int64(n) {
+		return 0, fmt.Errorf("index out of bounds: %d", i.Value)
+	}
+	return int(i.Value), nil
+}
+
+// stringArg returns args[i] as a Go string.
+func stringArg(args []Value, i int) (string, error) {
+	s, ok := args[i].(*String)
+	if !ok {
+		return "", fmt.Errorf("argument %d must be a string, got %s", i+1, TypeName(args[i]))
+	}
+	return s.Value, nil
+}
+
+// listPush appends values to the list in place and returns the list, like the
+// push builtin.
+func listPush(receiver Value, args ...Value) (Value, error) {
+	list := receiver.(*List)
+	if len(args) == 0 {
+		return nil, fmt.Errorf("wrong number of arguments: expected at least 1, got 0")
+	}
+	list.Elements = append(list.Elements, args...)
+	return list, nil
+}
+
+// listPop removes and returns the last element, or the element at the given
+// index.
+func listPop(receiver Value, args ...Value) (Value, error) {
+	list := receiver.(*List)
+	if err := checkArgs(args, 0, 1); err != nil {
+		return nil, err
+	}
+	if len(list.Elements) == 0 {
+		return nil, fmt.Errorf("pop from empty list")
+	}
+	i := len(list.Elements) - 1
+	if len(args) == 1 {
+		var err error
+		if i, err = index(args[0], len(list.Elements)); err != nil {
+			return nil, err
+		}
+	}
+	removed := list.Elements[i]
+	list.Elements = append(list.Elements[:i], list.Elements[i+1:]...)
+	return removed, nil
+}
+
+// listInsert inserts a value before the given index; the length of the list
+// appends it.
+func listInsert(receiver Value, args ...Value) (Value, error) {
+	list := receiver.(*List)
+	if err := checkArgs(args, 2, 2); err != nil {
+		return nil, err
+	}
+	i, err := index(args[0], len(list.Elements)+1)
+	if err != nil {
+		return nil, err
+	}
+	list.Elements = append(list.Elements, nil)
+	copy(list.Elements[i+1:], list.Elements[i:])
+	list.Elements[i] = args[1]
+	return &Nil{}, nil
+}
+
+// listRemove removes the first element equal to the argument.
+func listRemove(receiver Value, args ...Value) (Value, error) {
+	list := receiver.(*List)
+	if err := checkArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+	for i, el := range list.Elements {
+		if el.Equals(args[0]) {
+			list.Elements = append(list.Elements[:i], list.Elements[i+1:]...)
+			return &Nil{}, nil
+		}
+	}
+	return nil, fmt.Errorf("%s is not in the list", args[0].Inspect())
+}
+
+// listSort sorts the list in place in ascending order. The sort is stable and
+// fails if two elements cannot be compared.
+func listSort(receiver Value, args ...Value) (Value, error) {
+	list := receiver.(*List)
+	if err := checkArgs(args, 0, 0); err != nil {
+		return nil, err
+	}
+	var cmpErr error
+	sort.SliceStable(list.Elements, func(i, j int) bool {
+		cmp, err := list.Elements[i].Compare(list.Elements[j])
+		if err != nil && cmpErr == nil {
+			cmpErr = err
+		}
+		return cmp < 0
+	})
+	if cmpErr != nil {
+		return nil, cmpErr
+	}
+	return &Nil{}, nil
+}
+
+// listReverse reverses the list in place.
+func listReverse(receiver Value, args ...Value) (Value, error) {
+	list := receiver.(*List)
+	if err := checkArgs(args, 0, 0); err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(list.Elements)-1; i < j; i, j = i+1, j-1 {
+		list.Elements[i], list.Elements[j] = list.Elements[j], list.Elements[i]
+	}
+	return &Nil{}, nil
+}
+
+// listIndex returns the index of the first element equal to the argument.
+func listIndex(receiver Value, args ...Value) (Value, error) {
+	list := receiver.(*List)
+	if err := checkArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+	for i, el := range list.Elements {
+		if el.Equals(args[0]) {
+			return NewInteger(int64(i)), nil
+		}
+	}
+	return nil, fmt.Errorf("%s is not in the list", args[0].Inspect())
+}
+
+// stringSplit splits the string around each instance of a separator, or
+// around runs of white space when none is given.
+func stringSplit(receiver Value, args ...Value) (Value, error) {
+	s := receiver.(*String).Value
+	if err := checkArgs(args, 0, 1); err != nil {
+		return nil, err
+	}
+	var parts []string
+	if len(args) == 0 {
+		parts = strings.Fields(s)
+	} else {
+		sep, err := stringArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		if sep == "" {
+			return nil, fmt.Errorf("empty separator")
+		}
+		parts = strings.Split(s, sep)
+	}
+	elements := make([]Value, len(parts))
+	for i, part := range parts {
+		elements[i] = NewString(part)
+	}
+	return NewList(elements...), nil
+}
+
+// stringJoin concatenates a list of strings, with the string between them.
+func stringJoin(receiver Value, args ...Value) (Value, error) {
+	if err := checkArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+	list, ok := args[0].(*List)
+	if !ok {
+		return nil, fmt.Errorf("argument to join must be a list, got %s", TypeName(args[0]))
+	}
+	parts := make([]string, len(list.Elements))
+	for i, el := range list.Elements {
+		s, ok := el.(*String)
+		if !ok {
+			return nil, fmt.Errorf("element %d of the list is %s, not a string", i, TypeName(el))
+		}
+		parts[i] = s.Value
+	}
+	return NewString(strings.Join(parts, receiver.(*String).Value)), nil
+}
+
+func stringUpper(receiver Value, args ...Value) (Value, error) {
+	if err := checkArgs(args, 0, 0); err != nil {
+		return nil, err
+	}
+	return NewString(strings.ToUpper(receiver.(*String).Value)), nil
+}
+
+func stringLower(receiver Value, args ...Value) (Value, error) {
+	if err := checkArgs(args, 0, 0); err != nil {
+		return nil, err
+	}
+	return NewString(strings.ToLower(receiver.(*String).Value)), nil
+}
+
+// stringStrip removes leading and trailing white space, or the given
+// characters.
+func stringStrip(receiver Value, args ...Value) (Value, error) {
+	s := receiver.(*String).Value
+	if err := checkArgs(args, 0, 1); err != nil {
+		return nil, err
+	}
+	if len(args) == 0 {
+		return NewString(strings.TrimSpace(s)), nil
+	}
+	chars, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	return NewString(strings.Trim(s, chars)), nil
+}
+
+// stringFind returns the byte index of the first instance of a substring, or
+// -1 if there is none.
+func stringFind(receiver Value, args ...Value) (Value, error) {
+	if err := checkArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+	sub, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	return NewInteger(int64(strings.Index(receiver.(*String).Value, sub))), nil
+}
+
+// stringReplace replaces instances of a substring, all of them or only the
+// given number of first ones.
+func stringReplace(receiver Value, args ...Value) (Value, error) {
+	if err := checkArgs(args, 2, 3); err != nil {
+		return nil, err
+	}
+	old, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	replacement, err := stringArg(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	n := -1
+	if len(args) == 3 {
+		count, ok := args[2].(*Integer)
+		if !ok {
+			return nil, fmt.Errorf("argument 3 must be an integer, got %s", TypeName(args[2]))
+		}
+		n = int(count.Value)
+	}
+	return NewString(strings.Replace(receiver.(*String).Value, old, replacement, n)), nil
+}
+
+func stringStartsWith(receiver Value, args ...Value) (Value, error) {
+	if err := checkArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+	prefix, err := stringArg(args, 0)
+	if err != nil {
+		return nil, err
+	}
+	return NewBoolean(strings.HasPrefix(receiver.(*String).Value, prefix)), nil
+}
+
+// tableKeys returns the keys in insertion order, like the keys builtin.
+func tableKeys(receiver Value, args ...Value) (Value, error) {
+	if err := checkArgs(args, 0, 0); err != nil {
+		return nil, err
+	}
+	return builtinKeys(receiver)
+}
+
+// tableValues returns the values in insertion order.
+func tableValues(receiver Value, args ...Value) (Value, error) {
+	if err := checkArgs(args, 0, 0); err != nil {
+		return nil, err
+	}
+	table := receiver.(*Table)
+	values := make([]Value, len(table.Pairs))
+	for i, pair := range table.Pairs {
+		values[i] = pair.Value
+	}
+	return NewList(values...), nil
+}
+
+// tableItems returns (key, value) tuples in insertion order.
+func tableItems(receiver Value, args ...Value) (Value, error) {
+	if err := checkArgs(args, 0, 0); err != nil {
+		return nil, err
+	}
+	table := receiver.(*Table)
+	items := make([]Value, len(table.Pairs))
+	for i, pair := range table.Pairs {
+		items[i] = NewTuple(pair.Key, pair.Value)
+	}
+	return NewList(items...), nil
+}
+
+func tableHas(receiver Value, args ...Value) (Value, error) {
+	if err := checkArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+	if _, err := HashKeyOf(args[0]); err != nil {
+		return nil, err
+	}
+	return NewBoolean(receiver.(*Table).Has(args[0])), nil
+}
+
+// tableDelete removes a key and returns its value, or nil if the table has no
+// such key.
+func tableDelete(receiver Value, args ...Value) (Value, error) {
+	if err := checkArgs(args, 1, 1); err != nil {
+		return nil, err
+	}
+	if _, err := HashKeyOf(args[0]); err != nil {
+		return nil, err
+	}
+	if removed, ok := receiver.(*Table).Delete(args[0]); ok {
+		return removed, nil
+	}
+	return &Nil{}, nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	// Note: This package does NOT import "compiler" or "vm" to break the cycle.
 	// It only defines the interfaces and structs.
@@ -17,11 +18,13 @@ const (
 	BOOLEAN_OBJ  Type = "BOOLEAN"
 	NULL_OBJ     Type = "NULL"
 	LIST_OBJ     Type = "LIST"
+	TUPLE_OBJ    Type = "TUPLE"
 	TABLE_OBJ    Type = "TABLE"
 	FUNCTION_OBJ Type = "FUNCTION" // For CompiledFunction
 	CLOSURE_OBJ  Type = "CLOSURE"
 	ITERATOR_OBJ Type = "ITERATOR" // For iterators
 	ERROR_OBJ    Type = "ERROR"    // For runtime errors
+	BUILTIN_OBJ  Type = "BUILTIN"  // For native Go functions
 )
 
 // Value interface represents any runtime value.
@@ -76,7 +79,7 @@ func (i *Integer) Compare(other Value) (int, error) {
 		}
 		return 0, nil
 	}
-	return 0, fmt.Errorf("comparison not supported between Integer and %s", other.Type())
+	return 0, fmt.Errorf("comparison not supported between int and %s", TypeName(other))
 }
 func (i *Integer) GetIterator() (Iterator, error) { return nil, fmt.Errorf("integer is not iterable") }
 func (i *Integer) GetIndex(index Value) (Value, error) {
@@ -122,7 +125,7 @@ func (f *Float) Compare(other Value) (int, error) {
 		}
 		return 0, nil
 	}
-	return 0, fmt.Errorf("comparison not supported between Float and %s", other.Type())
+	return 0, fmt.Errorf("comparison not supported between float and %s", TypeName(other))
 }
 func (f *Float) GetIterator() (Iterator, error) { return nil, fmt.Errorf("float is not iterable") }
 func (f *Float) GetIndex(index Value) (Value, error) {
@@ -150,7 +153,7 @@ func (s *String) Compare(other Value) (int, error) {
 	if o, ok := other.(*String); ok {
 		return strings.Compare(s.Value, o.Value), nil
 	}
-	return 0, fmt.Errorf("comparison not supported between String and %s", other.Type())
+	return 0, fmt.Errorf("comparison not supported between string and %s", TypeName(other))
 }
 func (s *String) GetIterator() (Iterator, error) { return NewStringIterator(s), nil } // String is iterable
 func (s *String) GetIndex(index Value) (Value, error) {
@@ -185,7 +188,7 @@ func (b *Boolean) Equals(other Value) bool {
 	return false
 }
 func (b *Boolean) Compare(other Value) (int, error) {
-	return 0, fmt.Errorf("comparison not supported for Boolean")
+	return 0, fmt.Errorf("comparison not supported for %s", TypeName(b))
 }
 func (b *Boolean) GetIterator() (Iterator, error) { return nil, fmt.Errorf("boolean is not iterable") }
 func (b *Boolean) GetIndex(index Value) (Value, error) {
@@ -207,7 +210,7 @@ func (n *Nil) Equals(other Value) bool {
 	return ok
 }
 func (n *Nil) Compare(other Value) (int, error) {
-	return 0, fmt.Errorf("comparison not supported for Nil")
+	return 0, fmt.Errorf("comparison not supported for %s", TypeName(n))
 }
 func (n *Nil) GetIterator() (Iterator, error)        { return nil, fmt.Errorf("nil is not iterable") }
 func (n *Nil) GetIndex(index Value) (Value, error)   { return nil, fmt.Errorf("nil is not indexable") }
@@ -218,10 +221,83 @@ func (n *Nil) SetIndex(index Value, val Value) error { return fmt.Errorf("nil is
 // CompiledFunction value (represents the compiled code of a function)
 // Defined in the types package.
 type CompiledFunction struct {
-	Instructions  []byte // The bytecode for this function (using byte slice)
-	NumLocals     int    // Number of local variables (including parameters)
-	NumParameters int    // Number of parameters the function expects
-	FreeCount     int    // Number of free variables this function captures
+	Name          string      // Function name, or "<module>" for a module's top-level code
+	Instructions  []byte      // The bytecode for this function (using byte slice)
+	NumLocals     int         // Number of local variables (including parameters)
+	NumParameters int         // Number of parameters, including a variadic one
+	ParamNames    []string    // Parameter names, used to bind keyword arguments
+	NumDefaults   int         // Number of trailing fixed parameters with a default
+	Variadic      bool        // Whether the last parameter collects extra arguments
+	ParamTypes    []string    // Annotated parameter types, "" where unannotated; nil if none are
+	ReturnType    string      // Annotated result type; "" if unannotated
+	FreeCount     int         // Number of free variables this function captures
+	LocalNames    []string    // Names of the local slots, for listings
+	FreeNames     []string    // Names of the captured variables, for listings
+	File          string      // Source file the function was compiled from
+	Lines         []LineEntry // Source positions of the instructions, ordered by offset
+	Handlers      []Handler   // Error handlers of try statements, innermost first
+}
+
+// LineEntry records that the instructions from Offset up to the next entry were
+// compiled from the source at Line and Column.
+type LineEntry struct {
+	Offset int
+	Line   int
+	Column int
+}
+
+// Handler sends errors raised by the instructions from Start up to End to the
+// instruction at Target. Before jumping, the operand stack is cut back to Depth
+// values above the frame's locals and the error is pushed.
+type Handler struct {
+	Start  int
+	End    int
+	Target int
+	Depth  int
+}
+
+// Position is a location in a source file.
+type Position struct {
+	File   string
+	Line   int
+	Column int
+}
+
+// IsValid reports whether the position refers to a line.
+func (p Position) IsValid() bool { return p.Line > 0 }
+
+// String formats the position as file:line:col, leaving out the parts that are unknown.
+func (p Position) String() string {
+	s := p.File
+	if p.IsValid() {
+		if s != "" {
+			s += ":"
+		}
+		s += fmt.Sprintf("%d:%d", p.Line, p.Column)
+	}
+	if s == "" {
+		s = "-"
+	}
+	return s
+}
+
+// PositionAt returns the source position of the instruction at offset ip.
+func (cf *CompiledFunction) PositionAt(ip int) Position {
+	i := sort.Search(len(cf.Lines), func(i int) bool { return cf.Lines[i].Offset > ip }) - 1
+	if i < 0 {
+		return Position{File: cf.File}
+	}
+	return Position{File: cf.File, Line: cf.Lines[i].Line, Column: cf.Lines[i].Column}
+}
+
+// HandlerAt returns the innermost handler covering the instruction at offset ip.
+func (cf *CompiledFunction) HandlerAt(ip int) (Handler, bool) {
+	for _, h := range cf.Handlers {
+		if h.Start <= ip && ip < h.End {
+			return h, true
+		}
+	}
+	return Handler{}, false
 }
 
 func (cf *CompiledFunction) Type() Type { return FUNCTION_OBJ }
@@ -238,7 +314,7 @@ func (cf *CompiledFunction) Equals(other Value) bool {
 
 func (cf *CompiledFunction) Compare(other Value) (int, error) {
 	// Using the boilerplate from the second snippet as comparison isn't supported
-	return 0, fmt.Errorf("comparison not supported for Function")
+	return 0, fmt.Errorf("comparison not supported for %s", TypeName(cf))
 }
 
 func (cf *CompiledFunction) GetIterator() (Iterator, error) {
@@ -261,8 +337,20 @@ func (cf *CompiledFunction) SetIndex(index Value, val Value) error {
 // Closure value (a function plus its captured variables)
 // Defined in the types package.
 type Closure struct {
-	Fn   *CompiledFunction // Underlying function bytecode
-	Free []Value           // Captured free variables
+	Fn     *CompiledFunction // Underlying function bytecode
+	Free   []Value           // Captured free variables
+	Module *Module           // Module whose constants and globals the function uses
+}
+
+// Module is the runtime environment of one compiled unit (the main program or an
+// imported file). Every closure created while running a module's code keeps a
+// reference to it, so functions exported from an imported module still resolve
+// their constants and globals correctly when called from another module.
+type Module struct {
+	Name        string   // Module name ("main" for the entry program)
+	Constants   []Value  // Constant pool produced by the compiler
+	Globals     []Value  // Global variable slots
+	GlobalNames []string // Names of the global slots, for errors about unset globals
 }
 
 func (c *Closure) Type() Type { return CLOSURE_OBJ }
@@ -279,7 +367,7 @@ func (c *Closure) Equals(other Value) bool {
 
 func (c *Closure) Compare(other Value) (int, error) {
 	// Using the boilerplate from the second snippet as comparison isn't supported
-	return 0, fmt.Errorf("comparison not supported for Closure")
+	return 0, fmt.Errorf("comparison not supported for %s", TypeName(c))
 }
 
 func (c *Closure) GetIterator() (Iterator, error) {
@@ -327,7 +415,7 @@ func (l *List) Equals(other Value) bool {
 	return true
 }
 func (l *List) Compare(other Value) (int, error) {
-	return 0, fmt.Errorf("comparison not supported for List")
+	return 0, fmt.Errorf("comparison not supported for %s", TypeName(l))
 }
 func (l *List) GetIterator() (Iterator, error) { return NewListIterator(l), nil } // List is iterable
 func (l *List) GetIndex(index Value) (Value, error) {
@@ -357,11 +445,89 @@ func (l *List) SetIndex(index Value, val Value) error {
 // NewList helper
 func NewList(elements ...Value) *List { return &List{Elements: elements} }
 
+// Tuple is an immutable sequence. Tuples compare element by element and can
+// be table keys when their elements can.
+type Tuple struct {
+	Elements []Value
+}
+
+func (t *Tuple) Type() Type { return TUPLE_OBJ }
+func (t *Tuple) Inspect() string {
+	elements := make([]string, len(t.Elements))
+	for i, el := range t.Elements {
+		elements[i] = el.Inspect()
+	}
+	if len(elements) == 1 {
+		return "(" + elements[0] + ",)"
+	}
+	return "(" + strings.Join(elements, ", ") + ")"
+}
+func (t *Tuple) Equals(other Value) bool {
+	o, ok := other.(*Tuple)
+	if !ok || len(t.Elements) != len(o.Elements) {
+		return false
+	}
+	for i := range t.Elements {
+		if !t.Elements[i].Equals(o.Elements[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Compare orders tuples lexicographically: by the first elements that differ,
+// or by length if one is a prefix of the other.
+func (t *Tuple) Compare(other Value) (int, error) {
+	o, ok := other.(*Tuple)
+	if !ok {
+		return 0, fmt.Errorf("comparison not supported between tuple and %s", TypeName(other))
+	}
+	for i := 0; i < len(t.Elements) && i < len(o.Elements); i++ {
+		if t.Elements[i].Equals(o.Elements[i]) {
+			continue // Also skips equal elements of unordered types
+		}
+		cmp, err := t.Elements[i].Compare(o.Elements[i])
+		if err != nil || cmp != 0 {
+			return cmp, err
+		}
+	}
+	switch {
+	case len(t.Elements) < len(o.Elements):
+		return -1, nil
+	case len(t.Elements) > len(o.Elements):
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// GetIterator iterates over the elements. The iterator shares them, which is
+// safe since a tuple never changes.
+func (t *Tuple) GetIterator() (Iterator, error) {
+	return NewListIterator(&List{Elements: t.Elements}), nil
+}
+func (t *Tuple) GetIndex(index Value) (Value, error) {
+	idxInt, ok := index.(*Integer)
+	if !ok {
+		return nil, fmt.Errorf("tuple index must be an integer, got %s", index.Type())
+	}
+	idx := idxInt.Value
+	if idx < 0 || idx >= int64(len(t.Elements)) {
+		return nil, fmt.Errorf("tuple index out of bounds: %d", idx)
+	}
+	return t.Elements[idx], nil
+}
+func (t *Tuple) SetIndex(index Value, val Value) error {
+	return fmt.Errorf("tuple does not support item assignment")
+}
+
+// NewTuple helper
+func NewTuple(elements ...Value) *Tuple { return &Tuple{Elements: elements} }
+
 // --- Changes for Ordered Table Start ---
 
 // TablePair represents a single key-value pair within an ordered Table.
 type TablePair struct {
-	Key   string // Table keys are assumed to be strings
+	Key   Value // Always Hashable
 	Value Value
 }
 
@@ -370,8 +536,8 @@ type Table struct { // Defined in the types package
 	// Pairs stores the key-value pairs in the order they were added.
 	Pairs []TablePair
 	// Lookup provides O(1) average time complexity for accessing values by key.
-	// It maps the key string to the index of the pair in the Pairs slice.
-	Lookup map[string]int // Changed to uppercase 'L' to be exported
+	// It maps the hash key of each key to the index of its pair in Pairs.
+	Lookup map[HashKey]int
 }
 
 func (t *Table) Type() Type { return TABLE_OBJ }
@@ -381,7 +547,7 @@ func (t *Table) Inspect() string {
 	var fields []string
 	// Iterate over the ordered slice instead of the unordered map
 	for _, pair := range t.Pairs {
-		fields = append(fields, fmt.Sprintf("%s: %s", pair.Key, pair.Value.Inspect()))
+		fields = append(fields, fmt.Sprintf("%s: %s", pair.Key.Inspect(), pair.Value.Inspect()))
 	}
 	return "{" + strings.Join(fields, ", ") + "}"
 }
@@ -398,7 +564,7 @@ func (t *Table) Equals(other Value) bool {
 	}
 	// Compare each pair in order
 	for i := range t.Pairs {
-		if t.Pairs[i].Key != o.Pairs[i].Key || !t.Pairs[i].Value.Equals(o.Pairs[i].Value) {
+		if !t.Pairs[i].Key.Equals(o.Pairs[i].Key) || !t.Pairs[i].Value.Equals(o.Pairs[i].Value) {
 			return false
 		}
 	}
@@ -406,83 +572,87 @@ func (t *Table) Equals(other Value) bool {
 }
 
 func (t *Table) Compare(other Value) (int, error) {
-	return 0, fmt.Errorf("comparison not supported for Table")
+	return 0, fmt.Errorf("comparison not supported for %s", TypeName(t))
 }
 
 // GetIterator returns a TableIterator that iterates over the ordered keys.
 func (t *Table) GetIterator() (Iterator, error) { return NewTableIterator(t), nil } // Table is iterable (iterates over keys in order)
 
 // GetIndex retrieves a value by key using the Lookup map for efficiency.
+// Missing keys give nil; unhashable ones are an error.
 func (t *Table) GetIndex(index Value) (Value, error) {
-	keyStr, ok := index.(*String) // Assuming table keys are strings
-	if !ok {
-		return nil, fmt.Errorf("table index must be a string, got %s", index.Type())
+	hash, err := HashKeyOf(index)
+	if err != nil {
+		return nil, err
 	}
-
-	// Use the Lookup map for O(1) average time complexity access
-	if t.Lookup != nil { // Changed to uppercase 'L'
-		if idx, found := t.Lookup[keyStr.Value]; found { // Changed to uppercase 'L'
-			return t.Pairs[idx].Value, nil
-		}
-	} else {
-		// Fallback to linear scan if Lookup map is not initialized (less efficient)
-		for _, pair := range t.Pairs {
-			if pair.Key == keyStr.Value {
-				return pair.Value, nil
-			}
-		}
+	if idx, found := t.Lookup[hash]; found {
+		return t.Pairs[idx].Value, nil
 	}
-
 	// Return nil for non-existent keys
 	return &Nil{}, nil
 }
 
 // SetIndex sets or adds a value by key, maintaining insertion order.
 func (t *Table) SetIndex(index Value, val Value) error {
-	keyStr, ok := index.(*String) // Assuming table keys are strings
-	if !ok {
-		return fmt.Errorf("table index must be a string, got %s", index.Type())
+	hash, err := HashKeyOf(index)
+	if err != nil {
+		return err
 	}
-
-	key := keyStr.Value
-
-	// Check if the key already exists using the Lookup map
-	if t.Lookup != nil { // Changed to uppercase 'L'
-		if idx, found := t.Lookup[key]; found { // Changed to uppercase 'L'
-			t.Pairs[idx].Value = val // Update existing value in the ordered slice
-			return nil
-		}
-	} else {
-		// Fallback to linear scan if Lookup map is not initialized (less efficient)
-		for i, pair := range t.Pairs {
-			if pair.Key == key {
-				t.Pairs[i].Value = val // Update existing value
-				return nil
-			}
-		}
+	if idx, found := t.Lookup[hash]; found {
+		t.Pairs[idx].Value = val // Update existing value in the ordered slice
+		return nil
+	}
+	if t.Lookup == nil {
+		t.Lookup = make(map[HashKey]int)
 	}
-
 	// If the key does not exist, append a new pair to the end (maintains insertion order)
-	t.Pairs = append(t.Pairs, TablePair{Key: key, Value: val})
-	// If using a Lookup map, add the new key and its index
-	if t.Lookup != nil { // Changed to uppercase 'L'
-		t.Lookup[key] = len(t.Pairs) - 1 // Changed to uppercase 'L'
+	t.Pairs = append(t.Pairs, TablePair{Key: index, Value: val})
+	t.Lookup[hash] = len(t.Pairs) - 1
+	return nil
+}
+
+// Has reports whether the table holds key. Unhashable keys are never held.
+func (t *Table) Has(key Value) bool {
+	hash, err := HashKeyOf(key)
+	if err != nil {
+		return false
 	}
+	_, found := t.Lookup[hash]
+	return found
+}
 
-	return nil
+// Delete removes key, keeping the other pairs in order, and returns the value
+// it held. It reports false if the table has no such key.
+func (t *Table) Delete(key Value) (Value, bool) {
+	hash, err := HashKeyOf(key)
+	if err != nil {
+		return nil, false
+	}
+	idx, found := t.Lookup[hash]
+	if !found {
+		return nil, false
+	}
+	removed := t.Pairs[idx].Value
+	t.Pairs = append(t.Pairs[:idx], t.Pairs[idx+1:]...)
+	delete(t.Lookup, hash)
+	for i := idx; i < len(t.Pairs); i++ {
+		moved, _ := HashKeyOf(t.Pairs[i].Key) // Hashed when it was added
+		t.Lookup[moved] = i
+	}
+	return removed, true
 }
 
-// NewTable helper - Now takes a slice of TablePair to create an ordered table.
-// Note: If you still need to convert from a map, you would need a different helper
-// that accepts a map and defines an ordering (e.g., by sorting keys).
-// This helper is intended for creating tables where the order is known at creation.
+// NewTable creates an ordered table from pairs, in order. A key given twice
+// keeps its first position and its last value, as if the pairs were assigned
+// one by one. The keys must be hashable.
 func NewTable(pairs []TablePair) *Table {
-	// Initialize the Lookup map
-	lookup := make(map[string]int, len(pairs))
-	for i, pair := range pairs {
-		lookup[pair.Key] = i
+	t := &Table{Pairs: make([]TablePair, 0, len(pairs)), Lookup: make(map[HashKey]int, len(pairs))}
+	for _, pair := range pairs {
+		if err := t.SetIndex(pair.Key, pair.Value); err != nil {
+			panic(err)
+		}
 	}
-	return &Table{Pairs: pairs, Lookup: lookup} // Changed to uppercase 'L'
+	return t
 }
 
 // --- Changes for Ordered Table End ---
@@ -498,7 +668,7 @@ func (si *StringIterator) Type() Type              { return ITERATOR_OBJ } // It
 func (si *StringIterator) Inspect() string         { return fmt.Sprintf("<string iterator at %p>", si) }
 func (si *StringIterator) Equals(other Value) bool { return si == other } // Identity equality
 func (si *StringIterator) Compare(other Value) (int, error) {
-	return 0, fmt.Errorf("comparison not supported for Iterator")
+	return 0, fmt.Errorf("comparison not supported for %s", TypeName(si))
 }                                                         // Implement Compare
 func (si *StringIterator) GetIterator() (Iterator, error) { return si, nil } // Iterators are their own iterators
 func (si *StringIterator) GetIndex(index Value) (Value, error) {
@@ -528,7 +698,7 @@ func (li *ListIterator) Type() Type              { return ITERATOR_OBJ } // Iter
 func (li *ListIterator) Inspect() string         { return fmt.Sprintf("<list iterator at %p>", li) }
 func (li *ListIterator) Equals(other Value) bool { return li == other } // Identity equality
 func (li *ListIterator) Compare(other Value) (int, error) {
-	return 0, fmt.Errorf("comparison not supported for Iterator")
+	return 0, fmt.Errorf("comparison not supported for %s", TypeName(li))
 }                                                       // Implement Compare
 func (li *ListIterator) GetIterator() (Iterator, error) { return li, nil } // Iterators are their own iterators
 func (li *ListIterator) GetIndex(index Value) (Value, error) {
@@ -563,7 +733,7 @@ func (ti *TableIterator) Type() Type              { return ITERATOR_OBJ } // Ite
 func (ti *TableIterator) Inspect() string         { return fmt.Sprintf("<table iterator at %p>", ti) }
 func (ti *TableIterator) Equals(other Value) bool { return ti == other } // Identity equality
 func (ti *TableIterator) Compare(other Value) (int, error) {
-	return 0, fmt.Errorf("comparison not supported for Iterator")
+	return 0, fmt.Errorf("comparison not supported for %s", TypeName(ti))
 }                                                        // Implement Compare
 func (ti *TableIterator) GetIterator() (Iterator, error) { return ti, nil } // Iterators are their own iterators
 func (ti *TableIterator) GetIndex(index Value) (Value, error) {
@@ -581,13 +751,34 @@ func (ti *TableIterator) Next() (Value, bool, error) {
 	// Return the key of the current pair in order
 	key := ti.table.Pairs[ti.index].Key
 	ti.index++
-	return &String{Value: key}, true, nil // Return the key as a string value
+	return key, true, nil
 	// If you wanted to iterate over values or pairs, you would return those here instead.
 }
 
-// Error value for runtime errors
+// Error value for runtime errors. Errors raised by the VM and by `throw` record
+// where they were raised, so a caught error still knows its traceback.
 type Error struct {
-	Message string
+	Message   string
+	Value     Value        // Value passed to throw when it was not an error; nil otherwise
+	Pos       Position     // Where the error was raised
+	Traceback []TraceEntry // Active calls when the error was raised, outermost first
+	Cause     error        // Go error the error was raised from, if any; see Unwrap
+}
+
+// TraceEntry describes one call frame of a traceback.
+type TraceEntry struct {
+	Function string   // Name of the executing function
+	Pos      Position // Source position of the current instruction
+	Offset   int      // Offset of the current instruction in the function's bytecode
+}
+
+// String formats the entry as a traceback line: File "main.ins", line 2, col 12, in divide.
+func (t TraceEntry) String() string {
+	file := t.Pos.File
+	if file == "" {
+		file = "<input>"
+	}
+	return fmt.Sprintf("File %q, line %d, col %d, in %s", file, t.Pos.Line, t.Pos.Column, t.Function)
 }
 
 func (e *Error) Type() Type      { return ERROR_OBJ }
@@ -600,22 +791,112 @@ func (e *Error) Equals(other Value) bool {
 	return e.Message == o.Message
 }
 func (e *Error) Compare(other Value) (int, error) {
-	return 0, fmt.Errorf("comparison not supported for Error")
+	return 0, fmt.Errorf("comparison not supported for %s", TypeName(e))
 }
 func (e *Error) GetIterator() (Iterator, error) { return nil, fmt.Errorf("error is not iterable") }
+
+// GetIndex exposes the fields of a caught error: message, value (the thrown
+// value, or nil) and traceback (a list of lines, outermost call first).
 func (e *Error) GetIndex(index Value) (Value, error) {
-	return nil, fmt.Errorf("error is not indexable")
+	key, ok := index.(*String)
+	if !ok {
+		return nil, fmt.Errorf("error index must be a string, got %s", index.Type())
+	}
+	switch key.Value {
+	case "message":
+		return NewString(e.Message), nil
+	case "value":
+		if e.Value == nil {
+			return &Nil{}, nil
+		}
+		return e.Value, nil
+	case "traceback":
+		lines := make([]Value, len(e.Traceback))
+		for i, entry := range e.Traceback {
+			lines[i] = NewString(entry.String())
+		}
+		return NewList(lines...), nil
+	default:
+		return nil, fmt.Errorf("error has no field '%s'", key.Value)
+	}
+}
+func (e *Error) SetIndex(index Value, val Value) error {
+	return fmt.Errorf("error does not support item assignment")
 }
-func (e *Error) SetIndex(index Value, val Value) error { return fmt.Errorf("error is not indexable") }
 
 // Error method makes Error implement the `error` interface.
 func (e *Error) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the Go error the error was raised from, so hosts can test for
+// it with errors.Is and errors.As.
+func (e *Error) Unwrap() error { return e.Cause }
+
 // NewError helper
 func NewError(format string, a ...interface{}) *Error {
 	return &Error{Message: fmt.Sprintf(format, a...)}
 }
 
-// TODO: Add other value types as needed (e.g., Builtin)
+// NewThrownError wraps the operand of a throw statement. Errors are rethrown as
+// they are; any other value becomes the Value of a new error.
+func NewThrownError(val Value) *Error {
+	if e, ok := val.(*Error); ok {
+		return e
+	}
+	if s, ok := val.(*String); ok {
+		return &Error{Message: s.Value, Value: val}
+	}
+	return &Error{Message: val.Inspect(), Value: val}
+}
+
+// BuiltinFunction is the signature of native functions callable from scripts.
+type BuiltinFunction func(args ...Value) (Value, error)
+
+// SizedFunction is the signature of native functions whose result grows with
+// their arguments. They receive the run's size limit, 0 meaning none, so they
+// can fail with a *SizeLimitError before allocating.
+type SizedFunction func(maxSize int, args ...Value) (Value, error)
+
+// Builtin value wraps a native Go function.
+type Builtin struct {
+	Name     string
+	Fn       BuiltinFunction
+	Sized    SizedFunction // Called instead of Fn when set
+	Receiver Value         // The value a bound method was looked up on; nil for functions
+}
+
+// SizeLimitError is raised when a list, tuple, table or string would grow
+// larger than the run's size limit. Scripts can catch it with try.
+type SizeLimitError struct {
+	Kind  Type // LIST_OBJ, TUPLE_OBJ, TABLE_OBJ or STRING_OBJ
+	Size  int
+	Limit int
+}
+
+func (e *SizeLimitError) Error() string {
+	unit := "elements"
+	switch e.Kind {
+	case TABLE_OBJ:
+		unit = "entries"
+	case STRING_OBJ:
+		unit = "bytes"
+	}
+	return fmt.Sprintf("%s of %d %s exceeds the size limit of %d", strings.ToLower(string(e.Kind)), e.Size, unit, e.Limit)
+}
+
+func (b *Builtin) Type() Type      { return BUILTIN_OBJ }
+func (b *Builtin) Inspect() string { return fmt.Sprintf("<builtin %s>", b.Name) }
+func (b *Builtin) Equals(other Value) bool {
+	return b == other // Identity comparison, like closures
+}
+func (b *Builtin) Compare(other Value) (int, error) {
+	return 0, fmt.Errorf("comparison not supported for %s", TypeName(b))
+}
+func (b *Builtin) GetIterator() (Iterator, error) { return nil, fmt.Errorf("builtin is not iterable") }
+func (b *Builtin) GetIndex(index Value) (Value, error) {
+	return nil, fmt.Errorf("builtin is not indexable")
+}
+func (b *Builtin) SetIndex(index Value, val Value) error {
+	return fmt.Errorf("builtin is not indexable")
+}
@@ -0,0 +1,109 @@
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/SethGK/Inscript/internal/types"
+)
+
+// RuntimeError is an error raised while executing bytecode, together with the
+// source position of the instruction that raised it and the call stack at that
+// point.
+type RuntimeError struct {
+	Pos       types.Position
+	Err       error
+	Traceback []TraceEntry // Active calls, outermost first
+}
+
+// TraceEntry describes one call frame of a traceback.
+type TraceEntry = types.TraceEntry
+
+func (e *RuntimeError) Error() string {
+	return e.Pos.String() + ": " + e.Err.Error()
+}
+
+func (e *RuntimeError) Unwrap() error { return e.Err }
+
+// FormatTraceback renders the error with its traceback, most recent call last:
+//
+//	Traceback (most recent call last):
+//	  File "main.ins", line 5, col 7, in <module>
+//	  File "main.ins", line 2, col 12, in divide
+//	main.ins:2:12: division by zero
+func (e *RuntimeError) FormatTraceback() string {
+	var out strings.Builder
+	out.WriteString("Traceback (most recent call last):\n")
+	for _, entry := range e.Traceback {
+		fmt.Fprintf(&out, "  %s\n", entry)
+	}
+	out.WriteString(e.Error())
+	return out.String()
+}
+
+// raise converts err into the error value scripts catch, located at the
+// current instruction of the innermost frame together with the frame stack.
+// Rethrown errors keep the location they were first raised at. Errors raised
+// by an imported module already carry their own position; the importing
+// frames are added to their traceback. The Go error behind the value is kept
+// as its Cause.
+func (vm *VM) raise(err error) *types.Error {
+	var located *RuntimeError
+	if errors.As(err, &located) {
+		return &types.Error{
+			Message:   located.Err.Error(),
+			Pos:       located.Pos,
+			Traceback: append(vm.traceback(), located.Traceback...),
+			Cause:     errors.Unwrap(located.Err),
+		}
+	}
+	raised, ok := err.(*types.Error)
+	if !ok {
+		raised = &types.Error{Message: err.Error(), Cause: err}
+	}
+	if raised.Traceback == nil && vm.framesIndex > 0 {
+		frame := vm.currentFrame()
+		raised.Pos = frame.closure.Fn.PositionAt(frame.ip)
+		raised.Traceback = vm.traceback()
+	}
+	return raised
+}
+
+// unwind pops frames until one has a handler covering its current instruction,
+// then cuts that frame's operand stack back to the handler's depth, pushes the
+// error and continues at the handler. It reports whether a handler was found.
+func (vm *VM) unwind(raised *types.Error) bool {
+	for vm.framesIndex > 0 {
+		frame := vm.currentFrame()
+		fn := frame.closure.Fn
+		if handler, ok := fn.HandlerAt(frame.Instructions().InstructionStart(frame.ip)); ok {
+			// Calls reserve NumLocals slots, and Verify rejects a main program
+			// with locals, so the frame's operand stack starts here.
+			sp := frame.basePointer + fn.NumLocals + handler.Depth
+			for i := sp; i < vm.sp; i++ {
+				vm.stack[i] = nil // Clear references to allow GC
+			}
+			vm.sp = sp
+			frame.ip = handler.Target - 1 // The run loop advances ip before fetching
+			return vm.push(raised) == nil
+		}
+		vm.popFrame()
+	}
+	return false
+}
+
+// traceback describes the active frames, outermost first.
+func (vm *VM) traceback() []TraceEntry {
+	entries := make([]TraceEntry, 0, vm.framesIndex)
+	for _, frame := range vm.frames[:vm.framesIndex] {
+		fn := frame.closure.Fn
+		offset := frame.Instructions().InstructionStart(frame.ip)
+		entries = append(entries, TraceEntry{
+			Function: fn.Name,
+			Pos:      fn.PositionAt(offset),
+			Offset:   offset,
+		})
+	}
+	return entries
+}
@@ -0,0 +1,138 @@
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/SethGK/Inscript/internal/types"
+)
+
+// contextCheckInterval is how many instructions run between checks of the
+// run's context, so that checking it does not slow down every instruction.
+const contextCheckInterval = 1024
+
+// Limits bounds the resources a run may use, so that untrusted scripts cannot
+// run forever or exhaust memory. A zero field means no limit.
+type Limits struct {
+	MaxInstructions int64         // Instructions executed by one run
+	Timeout         time.Duration // Wall-clock time of one run
+	MaxSize         int           // Elements of a list or tuple, entries of a table or bytes of a string built or grown by an instruction, builtin or method
+	MaxCallDepth    int           // Nested calls; MaxFrames-1 applies when it is zero or larger
+}
+
+// InstructionLimitError is returned when a run executes more instructions than
+// Limits.MaxInstructions allows. Scripts cannot catch it.
+type InstructionLimitError struct {
+	Limit int64
+}
+
+func (e *InstructionLimitError) Error() string {
+	return fmt.Sprintf("instruction limit of %d exceeded", e.Limit)
+}
+
+// InterruptError is returned when the context of a run is canceled or its
+// deadline, or Limits.Timeout, passes. Err is the context's error. Scripts
+// cannot catch it.
+type InterruptError struct {
+	Err error
+}
+
+func (e *InterruptError) Error() string {
+	return "execution interrupted: " + e.Err.Error()
+}
+
+func (e *InterruptError) Unwrap() error { return e.Err }
+
+// SizeLimitError is raised when an instruction, builtin or method would build
+// a list, tuple, table or string larger than Limits.MaxSize. Scripts can
+// catch it with try.
+type SizeLimitError = types.SizeLimitError
+
+// CallDepthError is raised when a call would nest deeper than
+// Limits.MaxCallDepth. Scripts can catch it with try.
+type CallDepthError struct {
+	Limit int
+}
+
+func (e *CallDepthError) Error() string {
+	return fmt.Sprintf("maximum call depth of %d exceeded", e.Limit)
+}
+
+// SetLimits bounds the resources used by later runs.
+func (vm *VM) SetLimits(limits Limits) {
+	vm.limits = limits
+}
+
+// SetInstructionCounter makes later runs count their instructions in
+// *counter, starting from its current value, instead of from zero. VMs that
+// share a counter share one Limits.MaxInstructions budget.
+func (vm *VM) SetInstructionCounter(counter *int64) {
+	vm.counter = counter
+}
+
+// fatal reports whether err ends the run without running the script's
+// handlers, which could otherwise keep it going past its limits.
+func fatal(err error) bool {
+	var limit *InstructionLimitError
+	var interrupt *InterruptError
+	return errors.As(err, &limit) || errors.As(err, &interrupt)
+}
+
+// step counts an instruction against the instruction limit and, every
+// contextCheckInterval instructions, checks the run's context.
+func (vm *VM) step() error {
+	*vm.executed++
+	if max := vm.limits.MaxInstructions; max > 0 && *vm.executed > max {
+		return &InstructionLimitError{Limit: max}
+	}
+	if *vm.executed%contextCheckInterval == 0 && vm.ctx != nil {
+		if err := vm.ctx.Err(); err != nil {
+			return &InterruptError{Err: err}
+		}
+	}
+	return nil
+}
+
+// checkSize enforces Limits.MaxSize on a value an instruction, builtin or
+// method built or grew.
+func (vm *VM) checkSize(v types.Value) error {
+	max := vm.limits.MaxSize
+	if max <= 0 {
+		return nil
+	}
+	if size := sizeOf(v); size > max {
+		return &SizeLimitError{Kind: v.Type(), Size: size, Limit: max}
+	}
+	return nil
+}
+
+// sizeOf returns the size Limits.MaxSize measures, or 0 for values it does
+// not apply to.
+func sizeOf(v types.Value) int {
+	switch v := v.(type) {
+	case *types.List:
+		return len(v.Elements)
+	case *types.Tuple:
+		return len(v.Elements)
+	case *types.Table:
+		return len(v.Pairs)
+	case *types.String:
+		return len(v.Value)
+	}
+	return 0
+}
+
+// setIndex assigns value to aggregate[index], refusing to add an entry to a
+// table that already holds Limits.MaxSize entries.
+func (vm *VM) setIndex(aggregate, index, value types.Value) error {
+	if table, ok := aggregate.(*types.Table); ok {
+		if max := vm.limits.MaxSize; max > 0 && len(table.Pairs) >= max && !table.Has(index) {
+			return &SizeLimitError{Kind: types.TABLE_OBJ, Size: len(table.Pairs) + 1, Limit: max}
+		}
+	}
+	if err := aggregate.SetIndex(index, value); err != nil {
+		return types.NewError("runtime error: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package vm
+
+import (
+	"github.com/SethGK/Inscript/internal/compiler"
+	"github.com/SethGK/Inscript/internal/types"
+)
+
+// Tracer observes a run. It is called synchronously from the dispatch loop,
+// and the slices it is given are only valid for the duration of the call.
+type Tracer interface {
+	// OnInstruction is called before each instruction runs, with the function
+	// it belongs to, its offset, the frame's operand stack, bottom first, and
+	// the number of calls active.
+	OnInstruction(fn *types.CompiledFunction, offset int, op compiler.Opcode, operands []int, stack []types.Value, depth int)
+	// OnCall is called when a closure or builtin is entered, with its
+	// parameters (keyword arguments already bound, unset defaults nil) and
+	// the number of calls then active, counting this one.
+	OnCall(callee types.Value, args []types.Value, depth int)
+	// OnReturn is called when a call started by OnCall returns result.
+	OnReturn(callee types.Value, result types.Value, depth int)
+	// OnError is called when an error is raised, after the handler that
+	// catches it, if any, has been found.
+	OnError(err *types.Error, caught bool)
+}
+
+// SetTracer installs a tracer for later runs; nil, the default, disables
+// tracing.
+func (vm *VM) SetTracer(tracer Tracer) {
+	vm.tracer = tracer
+}
+
+// traceInstruction reports the instruction at ip of frame to the tracer.
+func (vm *VM) traceInstruction(frame *Frame, ip int) {
+	fn := frame.closure.Fn
+	op := compiler.ReadOpcode(frame.Instructions(), ip)
+	operands, _ := compiler.ReadOperands(op, frame.Instructions(), ip+1)
+	// Tracing must never fail a run, so clamp the operand stack's bottom in
+	// case the frame's locals were not reserved.
+	bottom := min(frame.basePointer+fn.NumLocals, vm.sp)
+	vm.tracer.OnInstruction(fn, ip, op, operands, vm.stack[bottom:vm.sp], vm.framesIndex-1)
+}
+
+// traceReturn reports that the closure of the current frame returns result.
+// It is called before the frame is popped.
+func (vm *VM) traceReturn(result types.Value) {
+	if vm.framesIndex > 1 { // The main program is not a call
+		vm.tracer.OnReturn(vm.currentFrame().closure, result, vm.framesIndex-1)
+	}
+}
@@ -2,6 +2,8 @@
 package vm
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"math"
@@ -17,17 +19,39 @@ const MaxFrames = 1024
 
 // VM represents the Inscript Virtual Machine.
 type VM struct {
-	constants []types.Value
+	bytecode *compiler.Bytecode // Checked by compiler.Verify before it runs
+	module   *types.Module      // The main module: its constant pool and global slots
 
 	stack []types.Value
 	sp    int // Stack pointer: points to the next free slot on the stack
 
-	globals []types.Value
-
 	frames      []*Frame // Call frames for function execution
 	framesIndex int      // Current frame index - points to the next free frame slot
 
 	outputWriter io.Writer
+
+	importer Importer // Resolves `import` statements; nil disables imports
+
+	limits   Limits
+	ctx      context.Context // Of the current run; nil outside RunContext
+	executed *int64          // Instructions executed by the current run and the imports it ran
+	counter  *int64          // Counter set with SetInstructionCounter; nil gives each run its own
+
+	tracer Tracer // Observes the run; nil disables tracing
+}
+
+// Importer loads the module named by an import path and returns the value the
+// importing code binds to the module's name.
+type Importer interface {
+	Import(path string) (types.Value, error)
+}
+
+// A MeteredImporter is an Importer that runs modules on VMs of its own. The
+// importing run passes its instruction counter, so that imported modules
+// count against the same Limits.MaxInstructions budget.
+type MeteredImporter interface {
+	Importer
+	ImportMetered(path string, executed *int64) (types.Value, error)
 }
 
 // Frame represents a single call frame for function execution.
@@ -57,28 +81,86 @@ func (f *Frame) Instructions() compiler.Instructions {
 func New(bytecode *compiler.Bytecode) *VM {
 	// The main program is treated as a function (the entry point).
 	mainFn := &types.CompiledFunction{
+		Name:          "<module>",
 		Instructions:  bytecode.Instructions,
 		NumLocals:     bytecode.NumLocals,
 		NumParameters: 0, // Main program has no parameters
 		FreeCount:     0,
+		File:          bytecode.File,
+		Lines:         bytecode.Lines,
+		Handlers:      bytecode.Handlers,
+	}
+	module := &types.Module{
+		Name:        "main",
+		Constants:   bytecode.Constants,
+		Globals:     make([]types.Value, bytecode.NumGlobals),
+		GlobalNames: bytecode.GlobalNames,
 	}
-	mainClosure := &types.Closure{Fn: mainFn, Free: []types.Value{}}
+	mainClosure := &types.Closure{Fn: mainFn, Free: []types.Value{}, Module: module}
 	mainFrame := NewFrame(mainClosure, 0) // Base pointer for main program is 0
 
 	frames := make([]*Frame, MaxFrames)
 	frames[0] = mainFrame
 
 	return &VM{
-		constants:    bytecode.Constants,
+		bytecode:     bytecode,
+		module:       module,
 		stack:        make([]types.Value, StackSize),
 		sp:           0,
-		globals:      make([]types.Value, bytecode.NumGlobals),
 		frames:       frames,
 		framesIndex:  1,         // Start with the main frame at index 0, next frame will be at index 1
 		outputWriter: os.Stdout, // Default output to stdout
 	}
 }
 
+// NewWithGlobals creates a VM whose main module uses the given global slots, so a
+// host can seed globals before running and read them back afterwards. The slice
+// is grown if the bytecode defines more globals than it holds.
+func NewWithGlobals(bytecode *compiler.Bytecode, globals []types.Value) *VM {
+	vm := New(bytecode)
+	if len(globals) < bytecode.NumGlobals {
+		grown := make([]types.Value, bytecode.NumGlobals)
+		copy(grown, globals)
+		globals = grown
+	}
+	vm.module.Globals = globals
+	return vm
+}
+
+// NewWithModule creates a VM that runs bytecode as more top-level code of
+// module, as a REPL does with each input. The module's globals are grown in
+// place to the slots the bytecode defines and its constants replaced by the
+// bytecode's pool, which extends the old one, so closures created by earlier
+// runs keep sharing the module's globals.
+func NewWithModule(bytecode *compiler.Bytecode, module *types.Module) *VM {
+	vm := New(bytecode)
+	if len(module.Globals) < bytecode.NumGlobals {
+		grown := make([]types.Value, bytecode.NumGlobals)
+		copy(grown, module.Globals)
+		module.Globals = grown
+	}
+	module.Constants = bytecode.Constants
+	module.GlobalNames = bytecode.GlobalNames
+	vm.module = module
+	vm.frames[0].closure.Module = module
+	return vm
+}
+
+// SetOutput redirects the output of print statements.
+func (vm *VM) SetOutput(w io.Writer) {
+	vm.outputWriter = w
+}
+
+// SetImporter installs the loader used to resolve import statements.
+func (vm *VM) SetImporter(importer Importer) {
+	vm.importer = importer
+}
+
+// Module returns the main module, whose globals hold the program's top-level variables.
+func (vm *VM) Module() *types.Module {
+	return vm.module
+}
+
 // currentFrame returns the currently executing call frame.
 func (vm *VM) currentFrame() *Frame {
 	return vm.frames[vm.framesIndex-1]
@@ -119,7 +201,6 @@ func (vm *VM) push(obj types.Value) error {
 	}
 	vm.stack[vm.sp] = obj
 	vm.sp++
-	fmt.Printf("DEBUG: PUSHED %s to SP=%d\n", obj.Inspect(), vm.sp-1) // vm.sp-1 is the index it was pushed to
 	return nil
 }
 
@@ -129,18 +210,65 @@ func (vm *VM) pop() (types.Value, error) {
 	}
 	vm.sp--
 	popped := vm.stack[vm.sp]
-	vm.stack[vm.sp] = nil                                                // Clear reference to allow GC
-	fmt.Printf("DEBUG: POPPED %s from SP=%d\n", popped.Inspect(), vm.sp) // vm.sp is the index it was popped from
+	vm.stack[vm.sp] = nil // Clear reference to allow GC
 	return popped, nil
 }
 
-// Run executes the compiled bytecode.
+// Run executes the compiled bytecode. An error raised inside a try statement
+// resumes execution at its handler; an error that no handler catches is
+// returned as a *RuntimeError, located at the instruction that raised it.
+// Bytecode that fails compiler.Verify is rejected with a *compiler.VerifyError
+// before anything runs.
 func (vm *VM) Run() error {
+	return vm.RunContext(context.Background())
+}
+
+// RunContext is like Run, but stops with an *InterruptError when ctx is
+// canceled or its deadline passes. The limits set with SetLimits apply to the
+// run; errors for exceeded limits are found in the returned *RuntimeError with
+// errors.As.
+func (vm *VM) RunContext(ctx context.Context) error {
+	if err := compiler.Verify(vm.bytecode); err != nil {
+		return err
+	}
+	if vm.limits.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, vm.limits.Timeout)
+		defer cancel()
+	}
+	if err := ctx.Err(); err != nil {
+		return &InterruptError{Err: err}
+	}
+	vm.ctx, vm.executed = ctx, vm.counter
+	if vm.executed == nil {
+		vm.executed = new(int64)
+	}
+	defer func() { vm.ctx = nil }()
+
+	for {
+		err := vm.run()
+		if err == nil {
+			return nil
+		}
+		raised := vm.raise(err)
+		caught := !fatal(raised) && vm.unwind(raised)
+		if vm.tracer != nil {
+			vm.tracer.OnError(raised, caught)
+		}
+		if !caught {
+			return &RuntimeError{Pos: raised.Pos, Err: raised, Traceback: raised.Traceback}
+		}
+	}
+}
+
+// run is the fetch-decode-execute loop behind Run.
+func (vm *VM) run() error {
 	var err error
 
 	for vm.framesIndex > 0 {
 		currentFrame := vm.currentFrame()
 		instructions := currentFrame.Instructions()
+		module := currentFrame.closure.Module
 
 		currentFrame.ip++
 		ip := currentFrame.ip
@@ -149,6 +277,9 @@ func (vm *VM) Run() error {
 			if vm.framesIndex > 1 {
 				// If not the main program, pop the frame and push nil as return value.
 				// The stack pointer should be reset to the base pointer of the previous frame.
+				if vm.tracer != nil {
+					vm.traceReturn(&types.Nil{})
+				}
 				frame := vm.popFrame()
 				vm.sp = frame.basePointer                     // Reset sp to where the callee was
 				if err := vm.push(&types.Nil{}); err != nil { // Push return value
@@ -158,6 +289,12 @@ func (vm *VM) Run() error {
 			}
 			break // Exit loop if main program finishes
 		}
+		if err := vm.step(); err != nil {
+			return err
+		}
+		if vm.tracer != nil {
+			vm.traceInstruction(currentFrame, ip)
+		}
 
 		opcode := compiler.ReadOpcode(instructions, ip)
 
@@ -165,7 +302,7 @@ func (vm *VM) Run() error {
 		case compiler.OpConstant:
 			constantIndex, bytesRead := compiler.ReadOperand(instructions, ip+1, 2)
 			currentFrame.ip += bytesRead
-			err = vm.push(vm.constants[constantIndex])
+			err = vm.push(module.Constants[constantIndex])
 			if err != nil {
 				return err
 			}
@@ -281,18 +418,22 @@ func (vm *VM) Run() error {
 			if err != nil {
 				return err
 			}
-			if int(globalIndex) >= len(vm.globals) {
-				return types.NewError("global variable index out of bounds: %d (max %d)", globalIndex, len(vm.globals)-1)
+			if int(globalIndex) >= len(module.Globals) {
+				return types.NewError("global variable index out of bounds: %d (max %d)", globalIndex, len(module.Globals)-1)
 			}
-			vm.globals[globalIndex] = value
+			module.Globals[globalIndex] = value
 
 		case compiler.OpGetGlobal:
 			globalIndex, bytesRead := compiler.ReadOperand(instructions, ip+1, 2)
 			currentFrame.ip += bytesRead
-			if int(globalIndex) >= len(vm.globals) {
-				return types.NewError("global variable index out of bounds: %d (max %d)", globalIndex, len(vm.globals)-1)
+			if int(globalIndex) >= len(module.Globals) {
+				return types.NewError("global variable index out of bounds: %d (max %d)", globalIndex, len(module.Globals)-1)
+			}
+			value := module.Globals[globalIndex]
+			if value == nil { // Assigned on a branch that never ran
+				return types.NewError("undefined variable '%s'", slotName(module.GlobalNames, globalIndex))
 			}
-			err = vm.push(vm.globals[globalIndex])
+			err = vm.push(value)
 			if err != nil {
 				return err
 			}
@@ -305,13 +446,14 @@ func (vm *VM) Run() error {
 				return err
 			}
 			vm.stack[currentFrame.basePointer+localIndex] = value
-			fmt.Printf("DEBUG: OpSetLocal %d setting local %d to %s (at stack index %d)\n", localIndex, localIndex, value.Inspect(), currentFrame.basePointer+localIndex)
 
 		case compiler.OpGetLocal:
 			localIndex, bytesRead := compiler.ReadOperand(instructions, ip+1, 1)
 			currentFrame.ip += bytesRead
 			valToPush := vm.stack[currentFrame.basePointer+localIndex]
-			fmt.Printf("DEBUG: OpGetLocal %d getting local %d, value: %s (from stack index %d)\n", localIndex, localIndex, valToPush.Inspect(), currentFrame.basePointer+localIndex)
+			if valToPush == nil {
+				return types.NewError("undefined variable '%s'", slotName(currentFrame.closure.Fn.LocalNames, localIndex))
+			}
 			err = vm.push(valToPush)
 			if err != nil {
 				return err
@@ -340,12 +482,23 @@ func (vm *VM) Run() error {
 				return err
 			}
 
+		case compiler.OpGetBuiltin:
+			builtinIndex, bytesRead := compiler.ReadOperand(instructions, ip+1, 1)
+			currentFrame.ip += bytesRead
+			if builtinIndex >= len(types.Builtins) {
+				return types.NewError("builtin index out of bounds: %d (max %d)", builtinIndex, len(types.Builtins)-1)
+			}
+			err = vm.push(types.Builtins[builtinIndex].Builtin)
+			if err != nil {
+				return err
+			}
+
 		case compiler.OpClosure:
 			constIndex, bytesRead := compiler.ReadOperand(instructions, ip+1, 2)
 			freeCount, bytesRead2 := compiler.ReadOperand(instructions, ip+1+bytesRead, 1)
 			currentFrame.ip += bytesRead + bytesRead2
 
-			fnVal := vm.constants[constIndex]
+			fnVal := module.Constants[constIndex]
 			compiledFn, ok := fnVal.(*types.CompiledFunction)
 			if !ok {
 				return types.NewError("constant %d is not a CompiledFunction, got %s", constIndex, fnVal.Type())
@@ -361,15 +514,22 @@ func (vm *VM) Run() error {
 				}
 				frees[i] = freedVal
 			}
-			clo := &types.Closure{Fn: compiledFn, Free: frees}
+			clo := &types.Closure{Fn: compiledFn, Free: frees, Module: module}
 			err = vm.push(clo)
 			if err != nil {
 				return err
 			}
 
-		case compiler.OpArray:
+		case compiler.OpArray, compiler.OpTuple:
 			numElements, bytesRead := compiler.ReadOperand(instructions, ip+1, 2)
 			currentFrame.ip += bytesRead
+			kind := types.LIST_OBJ
+			if opcode == compiler.OpTuple {
+				kind = types.TUPLE_OBJ
+			}
+			if max := vm.limits.MaxSize; max > 0 && numElements > max {
+				return &SizeLimitError{Kind: kind, Size: numElements, Limit: max}
+			}
 			elements := make([]types.Value, numElements)
 			// Elements are pushed in order, so pop them in reverse to build the list
 			for i := numElements - 1; i >= 0; i-- {
@@ -379,7 +539,11 @@ func (vm *VM) Run() error {
 				}
 				elements[i] = el
 			}
-			err = vm.push(types.NewList(elements...))
+			var aggregate types.Value = types.NewList(elements...)
+			if kind == types.TUPLE_OBJ {
+				aggregate = types.NewTuple(elements...)
+			}
+			err = vm.push(aggregate)
 			if err != nil {
 				return err
 			}
@@ -387,6 +551,9 @@ func (vm *VM) Run() error {
 		case compiler.OpTable:
 			numPairs, bytesRead := compiler.ReadOperand(instructions, ip+1, 2)
 			currentFrame.ip += bytesRead
+			if max := vm.limits.MaxSize; max > 0 && numPairs > max {
+				return &SizeLimitError{Kind: types.TABLE_OBJ, Size: numPairs, Limit: max}
+			}
 
 			pairs := make([]types.TablePair, numPairs)
 			// Pairs are pushed as (key, value) pairs. Pop value then key.
@@ -400,17 +567,31 @@ func (vm *VM) Run() error {
 				if err != nil {
 					return err
 				}
-				keyStr, ok := keyVal.(*types.String)
-				if !ok {
-					return types.NewError("table key must be a string, got %s", keyVal.Type())
+				pairs[i] = types.TablePair{Key: keyVal, Value: value}
+			}
+			table := types.NewTable(nil)
+			for _, pair := range pairs {
+				if setErr := table.SetIndex(pair.Key, pair.Value); setErr != nil {
+					return types.NewError("runtime error: %s", setErr.Error())
 				}
-				pairs[i] = types.TablePair{Key: keyStr.Value, Value: value}
 			}
-			err = vm.push(types.NewTable(pairs))
+			err = vm.push(table)
 			if err != nil {
 				return err
 			}
 
+		case compiler.OpDupTwo:
+			if vm.sp < 2 {
+				return types.NewError("stack underflow")
+			}
+			aggregate, index := vm.stack[vm.sp-2], vm.stack[vm.sp-1]
+			if err := vm.push(aggregate); err != nil {
+				return err
+			}
+			if err := vm.push(index); err != nil {
+				return err
+			}
+
 		case compiler.OpIndex:
 			index, err := vm.pop()
 			if err != nil {
@@ -429,6 +610,21 @@ func (vm *VM) Run() error {
 				return err
 			}
 
+		case compiler.OpGetAttr:
+			nameIndex, bytesRead := compiler.ReadOperand(instructions, ip+1, 2)
+			currentFrame.ip += bytesRead
+			object, err := vm.pop()
+			if err != nil {
+				return err
+			}
+			attr, attrErr := getAttr(object, module.Constants[nameIndex].(*types.String).Value)
+			if attrErr != nil {
+				return types.NewError("runtime error: %s", attrErr.Error())
+			}
+			if err := vm.push(attr); err != nil {
+				return err
+			}
+
 		case compiler.OpSetIndex:
 			value, err := vm.pop()
 			if err != nil {
@@ -442,9 +638,8 @@ func (vm *VM) Run() error {
 			if err != nil {
 				return err
 			}
-			setErr := aggregate.SetIndex(index, value)
-			if setErr != nil {
-				return types.NewError("runtime error: %s", setErr.Error())
+			if err := vm.setIndex(aggregate, index, value); err != nil {
+				return err
 			}
 			// SetIndex typically leaves the assigned value on stack
 			err = vm.push(value)
@@ -452,13 +647,45 @@ func (vm *VM) Run() error {
 				return err
 			}
 
+		case compiler.OpStoreIndex:
+			index, err := vm.pop()
+			if err != nil {
+				return err
+			}
+			aggregate, err := vm.pop()
+			if err != nil {
+				return err
+			}
+			value, err := vm.pop()
+			if err != nil {
+				return err
+			}
+			if err := vm.setIndex(aggregate, index, value); err != nil {
+				return err
+			}
+
+		case compiler.OpUnpack:
+			count, bytesRead := compiler.ReadOperand(instructions, ip+1, 2)
+			rest, bytesRead2 := compiler.ReadOperand(instructions, ip+1+bytesRead, 2)
+			currentFrame.ip += bytesRead + bytesRead2
+			value, err := vm.pop()
+			if err != nil {
+				return err
+			}
+			values, unpackErr := unpack(value, count, rest)
+			if unpackErr != nil {
+				return types.NewError("runtime error: %s", unpackErr.Error())
+			}
+			// Push in reverse so the first target's value is on top.
+			for i := len(values) - 1; i >= 0; i-- {
+				if err := vm.push(values[i]); err != nil {
+					return err
+				}
+			}
+
 		case compiler.OpPrint:
 			numExprs, bytesRead := compiler.ReadOperand(instructions, ip+1, 1)
 			currentFrame.ip += bytesRead
-			fmt.Printf("DEBUG: Stack before OpPrint (SP=%d, numExprs=%d):\n", vm.sp, numExprs)
-			for i := 0; i < vm.sp; i++ {
-				fmt.Printf("  [%d]: %+v\n", i, vm.stack[i].Inspect())
-			}
 			args := make([]string, numExprs)
 			// Pop elements from the stack (they come off right-to-left)
 			// and place them into the 'args' slice in the correct left-to-right order.
@@ -511,7 +738,6 @@ func (vm *VM) Run() error {
 					return err
 				}
 				currentFrame.ip += int(offset)
-				fmt.Printf("DEBUG: OpIterNext finished, jumping to %d. Stack top: %v\n", currentFrame.ip, vm.stack[vm.sp-1]) // Add this
 				continue
 			} else {
 
@@ -529,28 +755,60 @@ func (vm *VM) Run() error {
 				return types.NewError("runtime error: invalid callee position on stack. SP=%d, NumArgs=%d", vm.sp, numArgs)
 			}
 			callee := vm.stack[calleePos]
+			if builtin, ok := callee.(*types.Builtin); ok {
+				if err := vm.callBuiltin(builtin, calleePos, int(numArgs)); err != nil {
+					return err
+				}
+				continue
+			}
 			closure, ok := callee.(*types.Closure)
 			if !ok {
 				return types.NewError("call target is not a function or closure: %s", callee.Type())
 			}
-			if int(numArgs) != closure.Fn.NumParameters {
-				return types.NewError("wrong number of arguments: expected %d, got %d",
-					closure.Fn.NumParameters, numArgs)
+			if err := vm.callClosure(closure, calleePos, int(numArgs), 0); err != nil {
+				return err
 			}
 
-			argStart := calleePos + 1
-			newFrame := NewFrame(closure, argStart)
-			if err := vm.pushFrame(newFrame); err != nil {
+		case compiler.OpCallKw:
+			numArgs, bytesRead := compiler.ReadOperand(instructions, ip+1, 1)
+			numKeywords, bytesRead2 := compiler.ReadOperand(instructions, ip+1+bytesRead, 1)
+			currentFrame.ip += bytesRead + bytesRead2
+
+			calleePos := vm.sp - int(numArgs) - 2*int(numKeywords) - 1
+			if calleePos < 0 || calleePos >= vm.sp {
+				return types.NewError("runtime error: invalid callee position on stack. SP=%d, NumArgs=%d", vm.sp, numArgs)
+			}
+			callee := vm.stack[calleePos]
+			if builtin, ok := callee.(*types.Builtin); ok {
+				return types.NewError("%s() does not accept keyword arguments", builtin.Name)
+			}
+			closure, ok := callee.(*types.Closure)
+			if !ok {
+				return types.NewError("call target is not a function or closure: %s", callee.Type())
+			}
+			if err := vm.callClosure(closure, calleePos, int(numArgs), int(numKeywords)); err != nil {
 				return err
 			}
 
-			vm.sp = newFrame.basePointer + closure.Fn.NumLocals
+		case compiler.OpJumpIfBound:
+			offset, bytesRead := compiler.ReadOperand(instructions, ip+1, 2)
+			slot, bytesRead2 := compiler.ReadOperand(instructions, ip+1+bytesRead, 1)
+			currentFrame.ip += bytesRead + bytesRead2
+			if vm.stack[currentFrame.basePointer+slot] != nil {
+				currentFrame.ip += offset
+			}
 
 		case compiler.OpReturnValue:
 			returnValue, err := vm.pop()
 			if err != nil {
 				return err
 			}
+			if err := checkReturn(currentFrame.closure.Fn, returnValue); err != nil {
+				return err
+			}
+			if vm.tracer != nil {
+				vm.traceReturn(returnValue)
+			}
 			poppedFrame := vm.popFrame()
 
 			if vm.framesIndex > 0 {
@@ -561,12 +819,17 @@ func (vm *VM) Run() error {
 				vm.sp = poppedFrame.basePointer
 			}
 
-			fmt.Printf("DEBUG: OpReturnValue is pushing: %+v\n", returnValue)
 			if err := vm.push(returnValue); err != nil {
 				return err
 			}
 
 		case compiler.OpReturn:
+			if err := checkReturn(currentFrame.closure.Fn, &types.Nil{}); err != nil {
+				return err
+			}
+			if vm.tracer != nil {
+				vm.traceReturn(&types.Nil{})
+			}
 			poppedFrame := vm.popFrame()
 
 			if vm.framesIndex > 0 {
@@ -581,22 +844,100 @@ func (vm *VM) Run() error {
 			}
 
 		case compiler.OpImport: // Handle import statement
-			pathVal, err := vm.pop()
+			pathIndex, bytesRead := compiler.ReadOperand(instructions, ip+1, 2)
+			currentFrame.ip += bytesRead
+			pathStr, ok := module.Constants[pathIndex].(*types.String)
+			if !ok {
+				return types.NewError("import path must be a string, got %s", module.Constants[pathIndex].Type())
+			}
+			if vm.importer == nil {
+				return types.NewError("cannot import %q: no module loader configured", pathStr.Value)
+			}
+			var imported types.Value
+			var importErr error
+			if metered, ok := vm.importer.(MeteredImporter); ok {
+				imported, importErr = metered.ImportMetered(pathStr.Value, vm.executed)
+			} else {
+				imported, importErr = vm.importer.Import(pathStr.Value)
+			}
+			if importErr != nil {
+				return importErr
+			}
+			err = vm.push(imported)
 			if err != nil {
 				return err
 			}
-			pathStr, ok := pathVal.(*types.String)
-			if !ok {
-				return types.NewError("import path must be a string, got %s", pathVal.Type())
+
+		case compiler.OpPopJumpIfFalse:
+			offset, bytesRead := compiler.ReadOperand(instructions, ip+1, 2)
+			currentFrame.ip += bytesRead
+			condition, err := vm.pop()
+			if err != nil {
+				return err
+			}
+			if !isTruthy(condition) {
+				currentFrame.ip += offset
+			}
+
+		case compiler.OpEqualJump, compiler.OpNotEqualJump, compiler.OpLessThanJump,
+			compiler.OpLessEqualJump, compiler.OpGreaterThanJump, compiler.OpGreaterEqualJump:
+			offset, bytesRead := compiler.ReadOperand(instructions, ip+1, 2)
+			currentFrame.ip += bytesRead
+			right, err := vm.pop()
+			if err != nil {
+				return err
+			}
+			left, err := vm.pop()
+			if err != nil {
+				return err
+			}
+			result, err := compare(opcode, left, right)
+			if err != nil {
+				return err
+			}
+			if !result {
+				currentFrame.ip += offset
+			}
+
+		case compiler.OpAddLocalConst:
+			localIndex, bytesRead := compiler.ReadOperand(instructions, ip+1, 1)
+			constIndex, bytesRead2 := compiler.ReadOperand(instructions, ip+1+bytesRead, 2)
+			currentFrame.ip += bytesRead + bytesRead2
+			slot := currentFrame.basePointer + localIndex
+			if vm.stack[slot] == nil {
+				return types.NewError("undefined variable '%s'", slotName(currentFrame.closure.Fn.LocalNames, localIndex))
+			}
+			result, err := binaryOperation(compiler.OpAdd, vm.stack[slot], module.Constants[constIndex])
+			if err != nil {
+				return err
 			}
-			// TODO: Implement actual module loading/compilation/execution here.
-			// This would typically involve a module cache to avoid re-importing.
-			// For now, we'll just print a debug message and push nil.
-			fmt.Fprintf(vm.outputWriter, "DEBUG: Attempting to import module: %s (actual import logic not yet implemented)\n", pathStr.Value)
-			err = vm.push(&types.Nil{}) // Push nil for now, representing the imported module
+			if err := vm.checkSize(result); err != nil {
+				return err
+			}
+			vm.stack[slot] = result
+
+		case compiler.OpAddGlobalConst:
+			globalIndex, bytesRead := compiler.ReadOperand(instructions, ip+1, 2)
+			constIndex, bytesRead2 := compiler.ReadOperand(instructions, ip+1+bytesRead, 2)
+			currentFrame.ip += bytesRead + bytesRead2
+			if module.Globals[globalIndex] == nil {
+				return types.NewError("undefined variable '%s'", slotName(module.GlobalNames, globalIndex))
+			}
+			result, err := binaryOperation(compiler.OpAdd, module.Globals[globalIndex], module.Constants[constIndex])
+			if err != nil {
+				return err
+			}
+			if err := vm.checkSize(result); err != nil {
+				return err
+			}
+			module.Globals[globalIndex] = result
+
+		case compiler.OpThrow:
+			thrown, err := vm.pop()
 			if err != nil {
 				return err
 			}
+			return types.NewThrownError(thrown)
 
 		default:
 			return types.NewError("unknown opcode: %s", opcode.String()) // Use opcode.String() for better error messages
@@ -605,6 +946,186 @@ func (vm *VM) Run() error {
 	return nil
 }
 
+// callBuiltin invokes a native function with the numArgs values above calleePos
+// and replaces the callee and its arguments with the result.
+func (vm *VM) callBuiltin(builtin *types.Builtin, calleePos, numArgs int) error {
+	args := make([]types.Value, numArgs)
+	copy(args, vm.stack[calleePos+1:calleePos+1+numArgs])
+
+	if vm.tracer != nil {
+		vm.tracer.OnCall(builtin, args, vm.framesIndex)
+	}
+	before := sizeOf(builtin.Receiver)
+	var result types.Value
+	var err error
+	if builtin.Sized != nil {
+		result, err = builtin.Sized(vm.limits.MaxSize, args...)
+	} else {
+		result, err = builtin.Fn(args...)
+	}
+	if err != nil {
+		var sizeErr *SizeLimitError
+		if errors.As(err, &sizeErr) {
+			return sizeErr
+		}
+		return types.NewError("%s: %s", builtin.Name, err.Error())
+	}
+	if result == nil {
+		result = &types.Nil{}
+	}
+	if err := vm.checkSize(result); err != nil {
+		return err
+	}
+	// Methods such as insert grow their receiver without returning it.
+	if builtin.Receiver != nil && sizeOf(builtin.Receiver) > before {
+		if err := vm.checkSize(builtin.Receiver); err != nil {
+			return err
+		}
+	}
+	if vm.tracer != nil {
+		vm.tracer.OnReturn(builtin, result, vm.framesIndex)
+	}
+
+	for i := calleePos; i < vm.sp; i++ {
+		vm.stack[i] = nil // Clear references to allow GC
+	}
+	vm.sp = calleePos
+	return vm.push(result)
+}
+
+// callClosure binds the arguments above calleePos to the parameters of
+// closure and enters it. The numArgs positional arguments come first, followed
+// by numKeywords name/value pairs. Parameters left unbound stay nil so the
+// function's prologue can evaluate their defaults.
+func (vm *VM) callClosure(closure *types.Closure, calleePos, numArgs, numKeywords int) error {
+	fn := closure.Fn
+	name := fn.Name
+	numFixed := fn.NumParameters
+	if fn.Variadic {
+		numFixed--
+	}
+
+	params := make([]types.Value, fn.NumParameters)
+	args := vm.stack[calleePos+1 : calleePos+1+numArgs]
+	if numArgs > numFixed && !fn.Variadic {
+		return arityError(name, fn, numArgs+numKeywords)
+	}
+	copy(params, args[:min(numArgs, numFixed)])
+	if fn.Variadic {
+		rest := &types.List{Elements: []types.Value{}}
+		if numArgs > numFixed {
+			rest.Elements = append(rest.Elements, args[numFixed:]...)
+		}
+		params[numFixed] = rest
+	}
+
+	keywords := vm.stack[calleePos+1+numArgs : calleePos+1+numArgs+2*numKeywords]
+	for k := 0; k < len(keywords); k += 2 {
+		keyword, ok := keywords[k].(*types.String)
+		if !ok {
+			return types.NewError("%s() keywords must be strings, got %s", name, types.TypeName(keywords[k]))
+		}
+		key := keyword.Value
+		slot := -1
+		for i, param := range fn.ParamNames[:numFixed] {
+			if param == key {
+				slot = i
+				break
+			}
+		}
+		if slot < 0 {
+			return types.NewError("%s() got an unexpected keyword argument '%s'", name, key)
+		}
+		if params[slot] != nil {
+			return types.NewError("%s() got multiple values for argument '%s'", name, key)
+		}
+		params[slot] = keywords[k+1]
+	}
+
+	for i, param := range params[:numFixed-fn.NumDefaults] {
+		if param == nil {
+			if numKeywords == 0 {
+				return arityError(name, fn, numArgs)
+			}
+			return types.NewError("%s() missing argument '%s'", name, fn.ParamNames[i])
+		}
+	}
+
+	for i, param := range fn.ParamTypes {
+		if arg := params[i]; arg != nil && !types.Conforms(arg, param) {
+			return types.NewError("%s() argument '%s' must be %s, not %s", name, fn.ParamNames[i], param, types.TypeName(arg))
+		}
+	}
+
+	depth := vm.limits.MaxCallDepth
+	if depth <= 0 || depth > MaxFrames-1 {
+		depth = MaxFrames - 1
+	}
+	if vm.framesIndex > depth {
+		return &CallDepthError{Limit: depth}
+	}
+	base := calleePos + 1
+	if base+fn.NumLocals >= StackSize {
+		return types.NewError("stack overflow")
+	}
+	newFrame := NewFrame(closure, base)
+	if err := vm.pushFrame(newFrame); err != nil {
+		return err
+	}
+	copy(vm.stack[base:], params)
+	for i := base + len(params); i < max(vm.sp, base+fn.NumLocals); i++ {
+		vm.stack[i] = nil
+	}
+	vm.sp = base + fn.NumLocals
+	if vm.tracer != nil {
+		vm.tracer.OnCall(closure, params, vm.framesIndex-1)
+	}
+	return nil
+}
+
+// getAttr resolves object.name: a table's own key first, then a method of the
+// object's type bound to it, then the object indexed by name, which gives the
+// fields of an error. A table without the key or method gives nil.
+func getAttr(object types.Value, name string) (types.Value, error) {
+	key := types.NewString(name)
+	table, isTable := object.(*types.Table)
+	if isTable && table.Has(key) {
+		return table.GetIndex(key)
+	}
+	if method, ok := types.GetMethod(object, name); ok {
+		return method, nil
+	}
+	if isTable {
+		return &types.Nil{}, nil
+	}
+	value, err := object.GetIndex(key)
+	if err != nil {
+		return nil, fmt.Errorf("%s has no attribute '%s'", types.TypeName(object), name)
+	}
+	return value, nil
+}
+
+// checkReturn enforces the annotated result type of fn on a returned value.
+func checkReturn(fn *types.CompiledFunction, result types.Value) error {
+	if !types.Conforms(result, fn.ReturnType) {
+		return types.NewError("%s() must return %s, not %s", fn.Name, fn.ReturnType, types.TypeName(result))
+	}
+	return nil
+}
+
+// arityError reports a call with the wrong number of arguments.
+func arityError(name string, fn *types.CompiledFunction, given int) error {
+	required := fn.NumParameters - fn.NumDefaults
+	if fn.Variadic {
+		required--
+		return types.NewError("%s() takes at least %d argument(s) but %d were given", name, required, given)
+	}
+	if fn.NumDefaults > 0 {
+		return types.NewError("%s() takes from %d to %d arguments but %d were given", name, required, fn.NumParameters, given)
+	}
+	return types.NewError("%s() takes %d argument(s) but %d were given", name, fn.NumParameters, given)
+}
+
 // LastPoppedStackElem returns the last element popped from the stack.
 // Note: This method's behavior is tricky. If you need the value *after* a pop,
 // it's already returned by `pop()`. This method returns the element at `vm.stack[vm.sp]`
@@ -638,6 +1159,18 @@ func (vm *VM) executeBinaryOperation(op compiler.Opcode) error {
 	if err != nil {
 		return err
 	}
+	result, err := binaryOperation(op, left, right)
+	if err != nil {
+		return err
+	}
+	if err := vm.checkSize(result); err != nil {
+		return err
+	}
+	return vm.push(result)
+}
+
+// binaryOperation applies an arithmetic or power operator to two values.
+func binaryOperation(op compiler.Opcode, left, right types.Value) (types.Value, error) {
 
 	// --- NEW LIST CONCATENATION LOGIC ---
 	if op == compiler.OpAdd && left.Type() == types.LIST_OBJ && right.Type() == types.LIST_OBJ {
@@ -649,22 +1182,31 @@ func (vm *VM) executeBinaryOperation(op compiler.Opcode) error {
 		copy(newElements, leftList.Elements)
 		copy(newElements[len(leftList.Elements):], rightList.Elements)
 
-		return vm.push(types.NewList(newElements...)) // FIX: Added '...' spread operator
+		return types.NewList(newElements...), nil
 	}
 	// --- END NEW LIST CONCATENATION LOGIC ---
 
+	if op == compiler.OpAdd && left.Type() == types.TUPLE_OBJ && right.Type() == types.TUPLE_OBJ {
+		leftTuple := left.(*types.Tuple)
+		rightTuple := right.(*types.Tuple)
+		newElements := make([]types.Value, len(leftTuple.Elements)+len(rightTuple.Elements))
+		copy(newElements, leftTuple.Elements)
+		copy(newElements[len(leftTuple.Elements):], rightTuple.Elements)
+		return types.NewTuple(newElements...), nil
+	}
+
 	// String concatenation for OpAdd (keep this, it's correct)
 	if op == compiler.OpAdd && left.Type() == types.STRING_OBJ && right.Type() == types.STRING_OBJ {
 		leftStr := left.(*types.String).Value
 		rightStr := right.(*types.String).Value
-		return vm.push(types.NewString(leftStr + rightStr))
+		return types.NewString(leftStr + rightStr), nil
 	}
 
 	// Type checking for numeric operations
 	if (left.Type() != types.INTEGER_OBJ && left.Type() != types.FLOAT_OBJ) ||
 		(right.Type() != types.INTEGER_OBJ && right.Type() != types.FLOAT_OBJ) {
 		// If it's not numbers and not handled above (like lists or strings), it's an error
-		return types.NewError("type mismatch for %s: %s %s %s", op.String(), left.Type(), op.String(), right.Type())
+		return nil, types.NewError("type mismatch for %s: %s %s %s", op.String(), left.Type(), op.String(), right.Type())
 	}
 
 	var result types.Value
@@ -698,21 +1240,21 @@ func (vm *VM) executeBinaryOperation(op compiler.Opcode) error {
 		if left.Type() == types.INTEGER_OBJ && right.Type() == types.INTEGER_OBJ {
 			// Integer division with float result if not evenly divisible (common in many languages)
 			if right.(*types.Integer).Value == 0 {
-				return types.NewError("division by zero")
+				return nil, types.NewError("division by zero")
 			}
 			result = types.NewFloat(float64(left.(*types.Integer).Value) / float64(right.(*types.Integer).Value))
 		} else {
 			lVal := toFloat64(left)
 			rVal := toFloat64(right)
 			if rVal == 0.0 {
-				return types.NewError("division by zero")
+				return nil, types.NewError("division by zero")
 			}
 			result = types.NewFloat(lVal / rVal)
 		}
 	case compiler.OpMod:
 		if left.Type() == types.INTEGER_OBJ && right.Type() == types.INTEGER_OBJ {
 			if right.(*types.Integer).Value == 0 {
-				return types.NewError("modulo by zero")
+				return nil, types.NewError("modulo by zero")
 			}
 			result = types.NewInteger(left.(*types.Integer).Value % right.(*types.Integer).Value)
 		} else {
@@ -720,7 +1262,7 @@ func (vm *VM) executeBinaryOperation(op compiler.Opcode) error {
 			lVal := toFloat64(left)
 			rVal := toFloat64(right)
 			if rVal == 0.0 {
-				return types.NewError("modulo by zero")
+				return nil, types.NewError("modulo by zero")
 			}
 			result = types.NewFloat(math.Mod(lVal, rVal))
 		}
@@ -732,14 +1274,14 @@ func (vm *VM) executeBinaryOperation(op compiler.Opcode) error {
 		lVal := toFloat64(left)
 		rVal := toFloat64(right)
 		if rVal == 0.0 {
-			return types.NewError("integer division by zero")
+			return nil, types.NewError("integer division by zero")
 		}
 		result = types.NewInteger(int64(math.Floor(lVal / rVal)))
 	default:
-		return types.NewError("unknown operator for binary operation: %s", op.String())
+		return nil, types.NewError("unknown operator for binary operation: %s", op.String())
 	}
 
-	return vm.push(result)
+	return result, nil
 }
 
 // executeBitwiseOperation handles binary bitwise operations.
@@ -814,45 +1356,114 @@ func (vm *VM) executeComparisonOperation(op compiler.Opcode) error {
 	if err != nil {
 		return err
 	}
+	result, err := compare(op, left, right)
+	if err != nil {
+		return err
+	}
+	return vm.push(types.NewBoolean(result))
+}
 
+// compare applies a comparison operator, or the comparison of a compare-and-jump
+// superinstruction, to two values.
+func compare(op compiler.Opcode, left, right types.Value) (bool, error) {
 	var result bool
 	var errCmp error
 
 	switch op {
-	case compiler.OpEqual:
+	case compiler.OpEqual, compiler.OpEqualJump:
 		result = left.Equals(right)
-	case compiler.OpNotEqual:
+	case compiler.OpNotEqual, compiler.OpNotEqualJump:
 		result = !left.Equals(right)
-	case compiler.OpGreaterThan:
+	case compiler.OpGreaterThan, compiler.OpGreaterThanJump:
 		var cmp int
 		cmp, errCmp = left.Compare(right)
 		if errCmp != nil {
-			return types.NewError("runtime error: %s", errCmp.Error())
+			return false, types.NewError("runtime error: %s", errCmp.Error())
 		}
 		result = cmp > 0
-	case compiler.OpLessThan:
+	case compiler.OpLessThan, compiler.OpLessThanJump:
 		var cmp int
 		cmp, errCmp = left.Compare(right)
 		if errCmp != nil {
-			return types.NewError("runtime error: %s", errCmp.Error())
+			return false, types.NewError("runtime error: %s", errCmp.Error())
 		}
 		result = cmp < 0
-	case compiler.OpGreaterEqual: // New comparison
+	case compiler.OpGreaterEqual, compiler.OpGreaterEqualJump:
 		var cmp int
 		cmp, errCmp = left.Compare(right)
 		if errCmp != nil {
-			return types.NewError("runtime error: %s", errCmp.Error())
+			return false, types.NewError("runtime error: %s", errCmp.Error())
 		}
 		result = cmp >= 0
-	case compiler.OpLessEqual: // New comparison
+	case compiler.OpLessEqual, compiler.OpLessEqualJump:
 		var cmp int
 		cmp, errCmp = left.Compare(right)
 		if errCmp != nil {
-			return types.NewError("runtime error: %s", errCmp.Error())
+			return false, types.NewError("runtime error: %s", errCmp.Error())
 		}
 		result = cmp <= 0
 	default:
-		return types.NewError("unsupported comparison operation: %s", op)
+		return false, types.NewError("unsupported comparison operation: %s", op)
 	}
-	return vm.push(types.NewBoolean(result))
+	return result, nil
+}
+
+// unpack splits v into the count values of a destructuring assignment. If
+// rest is less than count, the target at that position takes a list of the
+// values the other targets leave over.
+func unpack(v types.Value, count, rest int) ([]types.Value, error) {
+	var elements []types.Value
+	switch seq := v.(type) {
+	case *types.List:
+		elements = seq.Elements
+	case *types.Tuple:
+		elements = seq.Elements
+	default:
+		iter, err := v.GetIterator()
+		if err != nil {
+			return nil, fmt.Errorf("cannot unpack %s", types.TypeName(v))
+		}
+		for {
+			el, ok, err := iter.Next()
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				break
+			}
+			elements = append(elements, el)
+			if rest == count && len(elements) > count {
+				return nil, fmt.Errorf("too many values to unpack (expected %d)", count)
+			}
+		}
+	}
+
+	if rest == count {
+		switch {
+		case len(elements) > count:
+			return nil, fmt.Errorf("too many values to unpack (expected %d, got %d)", count, len(elements))
+		case len(elements) < count:
+			return nil, fmt.Errorf("not enough values to unpack (expected %d, got %d)", count, len(elements))
+		}
+		return append([]types.Value(nil), elements...), nil
+	}
+
+	if len(elements) < count-1 {
+		return nil, fmt.Errorf("not enough values to unpack (expected at least %d, got %d)", count-1, len(elements))
+	}
+	tail := count - 1 - rest
+	values := make([]types.Value, 0, count)
+	values = append(values, elements[:rest]...)
+	middle := append([]types.Value(nil), elements[rest:len(elements)-tail]...)
+	values = append(values, types.NewList(middle...))
+	return append(values, elements[len(elements)-tail:]...), nil
+}
+
+// slotName returns the name of a global or local slot, or its index if the
+// bytecode does not name it.
+func slotName(names []string, index int) string {
+	if index < len(names) {
+		return names[index]
+	}
+	return fmt.Sprintf("#%d", index)
 }
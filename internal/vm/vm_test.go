@@ -0,0 +1,535 @@
+package vm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/SethGK/Inscript/internal/ast"
+	"github.com/SethGK/Inscript/internal/compiler"
+	"github.com/SethGK/Inscript/internal/types"
+)
+
+// runAsm assembles a listing, runs it under limits and returns what it printed.
+func runAsm(t *testing.T, src string, limits Limits) (string, error) {
+	t.Helper()
+	bytecode, err := compiler.Assemble(src)
+	if err != nil {
+		t.Fatalf("assemble: %v", err)
+	}
+	return run(bytecode, limits)
+}
+
+// runSource compiles a script, runs it and returns what it printed.
+func runSource(t *testing.T, src string) (string, error) {
+	t.Helper()
+	program, err := ast.ParseFile("test.ins", src)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	bytecode, err := compiler.New().Compile(program)
+	if err != nil {
+		t.Fatalf("compile: %v", err)
+	}
+	return run(bytecode, Limits{})
+}
+
+// run runs bytecode under limits and returns what it printed.
+func run(bytecode *compiler.Bytecode, limits Limits) (string, error) {
+	var out bytes.Buffer
+	machine := New(bytecode)
+	machine.SetOutput(&out)
+	machine.SetLimits(limits)
+	err := machine.Run()
+	return out.String(), err
+}
+
+// builtinIndex returns the OpGetBuiltin operand of the builtin called name.
+func builtinIndex(t *testing.T, name string) int {
+	t.Helper()
+	for i, def := range types.Builtins {
+		if def.Name == name {
+			return i
+		}
+	}
+	t.Fatalf("no builtin %s", name)
+	return -1
+}
+
+func TestRunAssembly(t *testing.T) {
+	tests := []struct {
+		name, src, out string
+	}{
+		{
+			name: "arithmetic",
+			src: `
+constants:
+  0  int 2
+  1  int 3
+  2  int 4
+
+function <module>
+  OpConstant 0
+  OpConstant 1
+  OpMul
+  OpConstant 2
+  OpAdd
+  OpPrint 1
+  OpNull
+  OpReturn
+`,
+			out: "10\n",
+		},
+		{
+			name: "counting loop",
+			src: `
+constants:
+  0  int 0
+  1  int 3
+  2  int 1
+
+function <module>
+  globals: n
+  OpConstant 0
+  OpSetGlobal 0
+loop:
+  OpGetGlobal 0
+  OpConstant 1
+  OpLessThan
+  OpJumpNotTruthy done
+  OpPop
+  OpGetGlobal 0
+  OpPrint 1
+  OpAddGlobalConst 0 2
+  OpJump loop
+done:
+  OpPop
+  OpNull
+  OpReturn
+`,
+			out: "0\n1\n2\n",
+		},
+		{
+			name: "closure",
+			src: `
+constants:
+  0  function add
+  1  int 40
+  2  int 2
+
+function <module>
+  OpConstant 1
+  OpClosure 0 1
+  OpConstant 2
+  OpCall 1
+  OpPrint 1
+  OpNull
+  OpReturn
+
+function add(x)
+  constant: 0
+  locals: x
+  free: base
+  OpGetFree 0
+  OpGetLocal 0
+  OpAdd
+  OpReturnValue
+`,
+			out: "42\n",
+		},
+		{
+			name: "handler",
+			src: `
+constants:
+  0  string "boom"
+
+function <module>
+  globals: e
+  handler: try-end -> catch, depth 0
+try:
+  OpConstant 0
+  OpThrow
+end:
+  OpNull
+  OpReturn
+catch:
+  OpSetGlobal 0
+  OpGetGlobal 0
+  OpPrint 1
+  OpNull
+  OpReturn
+`,
+			out: "ERROR: boom\n",
+		},
+		{
+			name: "unpack with rest",
+			src: `
+constants:
+  0  int 1
+  1  int 2
+  2  int 3
+  3  int 4
+
+function <module>
+  globals: first, middle, last
+  OpConstant 0
+  OpConstant 1
+  OpConstant 2
+  OpConstant 3
+  OpArray 4
+  OpUnpack 3 1
+  OpSetGlobal 0
+  OpSetGlobal 1
+  OpSetGlobal 2
+  OpGetGlobal 0
+  OpGetGlobal 1
+  OpGetGlobal 2
+  OpPrint 3
+  OpNull
+  OpReturn
+`,
+			out: "1 [2, 3] 4\n",
+		},
+	}
+	for _, tt := range tests {
+		out, err := runAsm(t, tt.src, Limits{})
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if out != tt.out {
+			t.Errorf("%s: printed %q, want %q", tt.name, out, tt.out)
+		}
+	}
+}
+
+func TestRunSource(t *testing.T) {
+	tests := []struct {
+		name, src, out string
+	}{
+		{
+			// Each branch pops the condition, or the loop overflows the stack.
+			name: "if in a loop",
+			src: `
+n = 0
+for i in range(3000) { if i % 2 == 0 { n += 1 } else { n -= 1 } }
+print(n)
+`,
+			out: "0\n",
+		},
+		{
+			name: "return from both branches",
+			src: `
+function pick(x) { if x { return 1 } else { return 2 } }
+print(pick(true), pick(false))
+`,
+			out: "1 2\n",
+		},
+		{
+			name: "for with break and continue",
+			src: `
+s = 0
+for i in range(10) { if i == 3 { continue } if i == 8 { break } s += i }
+print(s)
+`,
+			out: "25\n",
+		},
+		{
+			// break pops the inner iterator, so the outer loop still finds its own.
+			name: "break from a nested for",
+			src: `
+for i in range(2) { for j in range(5) { if j == 1 { break } print(i, j) } }
+`,
+			out: "0 0\n1 0\n",
+		},
+		{
+			name: "break out of try",
+			src: `
+for i in range(3) { try { if i == 1 { break } print(i) } finally { print("finally") } }
+print("end")
+`,
+			out: "0\nfinally\nfinally\nend\n",
+		},
+		{
+			name: "while",
+			src: `
+n = 0
+while n < 5 { n = n + 1 }
+print(n)
+`,
+			out: "5\n",
+		},
+		{
+			name: "while with break and continue",
+			src: `
+n = 0
+s = 0
+while true { n = n + 1 if n % 2 == 0 { continue } if n > 7 { break } s += n }
+print(s)
+`,
+			out: "16\n",
+		},
+		{
+			name: "table items are tuples",
+			src: `
+t = {a = 1}
+print(t.items())
+for k, v in t.items() { print(k, v) }
+`,
+			out: "[(a, 1)]\na 1\n",
+		},
+	}
+	for _, tt := range tests {
+		out, err := runSource(t, tt.src)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if out != tt.out {
+			t.Errorf("%s: printed %q, want %q", tt.name, out, tt.out)
+		}
+	}
+}
+
+func TestRunAssemblyErrors(t *testing.T) {
+	tests := []struct {
+		name, src, err string
+	}{
+		{
+			// A global assigned on a branch that never ran holds no value.
+			name: "unassigned global",
+			src: `
+function <module>
+  globals: x
+  OpGetGlobal 0
+  OpPop
+  OpNull
+  OpReturn
+`,
+			err: "undefined variable 'x'",
+		},
+		{
+			name: "unassigned local",
+			src: `
+constants:
+  0  function f
+
+function <module>
+  OpClosure 0 0
+  OpCall 0
+  OpPop
+  OpNull
+  OpReturn
+
+function f()
+  constant: 0
+  locals: y
+  OpGetLocal 0
+  OpReturnValue
+`,
+			err: "undefined variable 'y'",
+		},
+		{
+			// Verify cannot see the types of keyword names, so the VM checks them.
+			name: "keyword name not a string",
+			src: `
+constants:
+  0  function f
+  1  int 1
+
+function <module>
+  OpClosure 0 0
+  OpConstant 1
+  OpConstant 1
+  OpCallKw 0 1
+  OpPop
+  OpNull
+  OpReturn
+
+function f(a)
+  constant: 0
+  locals: a
+  OpGetLocal 0
+  OpReturnValue
+`,
+			err: "f() keywords must be strings, got int",
+		},
+		{
+			name: "unpack arity",
+			src: `
+constants:
+  0  int 1
+
+function <module>
+  OpConstant 0
+  OpConstant 0
+  OpConstant 0
+  OpTuple 3
+  OpUnpack 2 2
+  OpPop
+  OpPop
+  OpNull
+  OpReturn
+`,
+			err: "too many values to unpack (expected 2, got 3)",
+		},
+	}
+	for _, tt := range tests {
+		_, err := runAsm(t, tt.src, Limits{})
+		var runtimeErr *RuntimeError
+		if !errors.As(err, &runtimeErr) || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("%s: got %v, want a runtime error containing %q", tt.name, err, tt.err)
+		}
+	}
+}
+
+func TestRunAssemblyLimits(t *testing.T) {
+	loop := `
+function <module>
+loop:
+  OpJump loop
+`
+	_, err := runAsm(t, loop, Limits{MaxInstructions: 100})
+	var limitErr *InstructionLimitError
+	if !errors.As(err, &limitErr) || limitErr.Limit != 100 {
+		t.Errorf("endless loop: got %v, want an *InstructionLimitError", err)
+	}
+
+	// range counts its elements and refuses before building the list.
+	rangeCall := fmt.Sprintf(`
+constants:
+  0  int 100000000000
+
+function <module>
+  OpGetBuiltin %d
+  OpConstant 0
+  OpCall 1
+  OpPop
+  OpNull
+  OpReturn
+`, builtinIndex(t, "range"))
+	_, err = runAsm(t, rangeCall, Limits{MaxSize: 10, MaxInstructions: 100})
+	var sizeErr *SizeLimitError
+	if !errors.As(err, &sizeErr) || sizeErr.Size != 100000000000 || sizeErr.Limit != 10 {
+		t.Errorf("range: got %v, want a *SizeLimitError", err)
+	}
+
+	recursion := `
+constants:
+  0  function f
+
+function <module>
+  globals: f
+  OpClosure 0 0
+  OpSetGlobal 0
+  OpGetGlobal 0
+  OpCall 0
+  OpPop
+  OpNull
+  OpReturn
+
+function f()
+  constant: 0
+  OpGetGlobal 0
+  OpCall 0
+  OpReturnValue
+`
+	_, err = runAsm(t, recursion, Limits{})
+	var depthErr *CallDepthError
+	if !errors.As(err, &depthErr) || depthErr.Limit != MaxFrames-1 {
+		t.Errorf("recursion: got %v, want a *CallDepthError at MaxFrames-1", err)
+	}
+}
+
+func TestVerifyBeforeRun(t *testing.T) {
+	_, err := runAsm(t, "function <module>\n  OpPop\n  OpNull\n  OpReturn", Limits{})
+	var verifyErr *compiler.VerifyError
+	if !errors.As(err, &verifyErr) {
+		t.Errorf("stack underflow: got %v, want a *compiler.VerifyError", err)
+	}
+
+	// The assembler rejects negative counts, so encode OpTable -2 by hand.
+	ins := append(compiler.Instructions{byte(compiler.OpTable), 0xff, 0xfe}, compiler.Make(compiler.OpPop)...)
+	ins = append(ins, compiler.Make(compiler.OpNull)...)
+	ins = append(ins, compiler.Make(compiler.OpReturn)...)
+	err = New(&compiler.Bytecode{Instructions: ins}).Run()
+	if !errors.As(err, &verifyErr) || !strings.Contains(err.Error(), "count -2 is negative") {
+		t.Errorf("negative count: got %v, want a *compiler.VerifyError", err)
+	}
+}
+
+func TestDeadBranchGlobal(t *testing.T) {
+	// Folding drops the branch that assigns x, leaving its slot unset.
+	_, err := runSource(t, "if false { x = 1 }\nprint(x)")
+	var runtimeErr *RuntimeError
+	if !errors.As(err, &runtimeErr) || !strings.Contains(err.Error(), "undefined variable 'x'") {
+		t.Errorf("got %v, want a runtime error about undefined variable 'x'", err)
+	}
+}
+
+func TestSortMixedTypes(t *testing.T) {
+	_, err := runSource(t, "x = [1, \"a\"]\nx.sort()")
+	if err == nil || !strings.Contains(err.Error(), "comparison not supported between string and int") {
+		t.Errorf("got %v, want an error naming string and int", err)
+	}
+}
+
+// stackTracer records the operand stack depth before each instruction.
+type stackTracer struct {
+	depths []int
+}
+
+func (s *stackTracer) OnInstruction(fn *types.CompiledFunction, offset int, op compiler.Opcode, operands []int, stack []types.Value, depth int) {
+	s.depths = append(s.depths, len(stack))
+}
+func (s *stackTracer) OnCall(callee types.Value, args []types.Value, depth int)   {}
+func (s *stackTracer) OnReturn(callee types.Value, result types.Value, depth int) {}
+func (s *stackTracer) OnError(err *types.Error, caught bool)                      {}
+
+func TestTracer(t *testing.T) {
+	bytecode, err := compiler.Assemble(`
+constants:
+  0  int 1
+
+function <module>
+  OpConstant 0
+  OpConstant 0
+  OpAdd
+  OpPop
+  OpNull
+  OpReturn
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracer := &stackTracer{}
+	machine := New(bytecode)
+	machine.SetTracer(tracer)
+	if err := machine.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fmt.Sprint(tracer.depths), "[0 1 2 1 0 1]"; got != want {
+		t.Errorf("traced stack depths %s, want %s", got, want)
+	}
+
+	// The VM reserves no locals for the main program, so Verify refuses one
+	// that declares them rather than letting the tracer see a bad stack.
+	bytecode, err = compiler.Assemble(`
+function <module>
+  locals: a, b, c
+  OpNull
+  OpReturn
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	machine = New(bytecode)
+	machine.SetTracer(&stackTracer{})
+	err = machine.Run()
+	var verifyErr *compiler.VerifyError
+	if !errors.As(err, &verifyErr) || !strings.Contains(err.Error(), "main program cannot have locals") {
+		t.Errorf("main with locals: got %v, want a *compiler.VerifyError", err)
+	}
+}
@@ -129,6 +129,30 @@ func (s *BaseInscriptListener) EnterPrintStmt(ctx *PrintStmtContext) {}
 // ExitPrintStmt is called when production printStmt is exited.
 func (s *BaseInscriptListener) ExitPrintStmt(ctx *PrintStmtContext) {}
 
+// EnterTryStmt is called when production tryStmt is entered.
+func (s *BaseInscriptListener) EnterTryStmt(ctx *TryStmtContext) {}
+
+// ExitTryStmt is called when production tryStmt is exited.
+func (s *BaseInscriptListener) ExitTryStmt(ctx *TryStmtContext) {}
+
+// EnterThrowStmt is called when production throwStmt is entered.
+func (s *BaseInscriptListener) EnterThrowStmt(ctx *ThrowStmtContext) {}
+
+// ExitThrowStmt is called when production throwStmt is exited.
+func (s *BaseInscriptListener) ExitThrowStmt(ctx *ThrowStmtContext) {}
+
+// EnterFunctionLiteral is called when production functionLiteral is entered.
+func (s *BaseInscriptListener) EnterFunctionLiteral(ctx *FunctionLiteralContext) {}
+
+// ExitFunctionLiteral is called when production functionLiteral is exited.
+func (s *BaseInscriptListener) ExitFunctionLiteral(ctx *FunctionLiteralContext) {}
+
+// EnterArrowFunction is called when production arrowFunction is entered.
+func (s *BaseInscriptListener) EnterArrowFunction(ctx *ArrowFunctionContext) {}
+
+// ExitArrowFunction is called when production arrowFunction is exited.
+func (s *BaseInscriptListener) ExitArrowFunction(ctx *ArrowFunctionContext) {}
+
 // EnterGeExpr is called when production geExpr is entered.
 func (s *BaseInscriptListener) EnterGeExpr(ctx *GeExprContext) {}
 
@@ -309,6 +333,30 @@ func (s *BaseInscriptListener) EnterArgList(ctx *ArgListContext) {}
 // ExitArgList is called when production argList is exited.
 func (s *BaseInscriptListener) ExitArgList(ctx *ArgListContext) {}
 
+// EnterArgument is called when production argument is entered.
+func (s *BaseInscriptListener) EnterArgument(ctx *ArgumentContext) {}
+
+// ExitArgument is called when production argument is exited.
+func (s *BaseInscriptListener) ExitArgument(ctx *ArgumentContext) {}
+
+// EnterTargetList is called when production targetList is entered.
+func (s *BaseInscriptListener) EnterTargetList(ctx *TargetListContext) {}
+
+// ExitTargetList is called when production targetList is exited.
+func (s *BaseInscriptListener) ExitTargetList(ctx *TargetListContext) {}
+
+// EnterTargetItem is called when production targetItem is entered.
+func (s *BaseInscriptListener) EnterTargetItem(ctx *TargetItemContext) {}
+
+// ExitTargetItem is called when production targetItem is exited.
+func (s *BaseInscriptListener) ExitTargetItem(ctx *TargetItemContext) {}
+
+// EnterExpressionList is called when production expressionList is entered.
+func (s *BaseInscriptListener) EnterExpressionList(ctx *ExpressionListContext) {}
+
+// ExitExpressionList is called when production expressionList is exited.
+func (s *BaseInscriptListener) ExitExpressionList(ctx *ExpressionListContext) {}
+
 // EnterPrimary is called when production primary is entered.
 func (s *BaseInscriptListener) EnterPrimary(ctx *PrimaryContext) {}
 
@@ -80,6 +80,22 @@ func (v *BaseInscriptVisitor) VisitPrintStmt(ctx *PrintStmtContext) interface{}
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseInscriptVisitor) VisitTryStmt(ctx *TryStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseInscriptVisitor) VisitThrowStmt(ctx *ThrowStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseInscriptVisitor) VisitFunctionLiteral(ctx *FunctionLiteralContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseInscriptVisitor) VisitArrowFunction(ctx *ArrowFunctionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseInscriptVisitor) VisitGeExpr(ctx *GeExprContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -200,6 +216,22 @@ func (v *BaseInscriptVisitor) VisitArgList(ctx *ArgListContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseInscriptVisitor) VisitArgument(ctx *ArgumentContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseInscriptVisitor) VisitTargetList(ctx *TargetListContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseInscriptVisitor) VisitTargetItem(ctx *TargetItemContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseInscriptVisitor) VisitExpressionList(ctx *ExpressionListContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseInscriptVisitor) VisitPrimary(ctx *PrimaryContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -49,7 +49,8 @@ func inscriptlexerLexerInit() {
 		"'//'", "'%'", "'&'", "'|'", "'^'", "'~'", "'<<'", "'>>'", "'=='", "'!='",
 		"'<'", "'<='", "'>'", "'>='", "'='", "'+='", "'-='", "'*='", "'/='",
 		"'^^='", "'->'", "'('", "')'", "'['", "']'", "'{'", "'}'", "','", "'.'",
-		"':'", "'...'",
+		"':'", "'...'", "", "", "", "", "", "", "'try'", "'throw'", "'catch'",
+		"'finally'",
 	}
 	staticData.SymbolicNames = []string{
 		"", "FUNCTION", "IF", "ELSE", "WHILE", "FOR", "IN", "BREAK", "CONTINUE",
@@ -59,7 +60,7 @@ func inscriptlexerLexerInit() {
 		"ASSIGN", "ADD_ASSIGN", "SUB_ASSIGN", "MUL_ASSIGN", "DIV_ASSIGN", "POW_ASSIGN",
 		"ARROW", "LPAREN", "RPAREN", "LBRACK", "RBRACK", "LBRACE", "RBRACE",
 		"COMMA", "DOT", "COLON", "ELLIPSIS", "IDENTIFIER", "NUMBER", "STRING",
-		"COMMENT", "BLOCK_COMMENT", "WS",
+		"COMMENT", "BLOCK_COMMENT", "WS", "TRY", "THROW", "CATCH", "FINALLY",
 	}
 	staticData.RuleNames = []string{
 		"FUNCTION", "IF", "ELSE", "WHILE", "FOR", "IN", "BREAK", "CONTINUE",
@@ -69,11 +70,12 @@ func inscriptlexerLexerInit() {
 		"ASSIGN", "ADD_ASSIGN", "SUB_ASSIGN", "MUL_ASSIGN", "DIV_ASSIGN", "POW_ASSIGN",
 		"ARROW", "LPAREN", "RPAREN", "LBRACK", "RBRACK", "LBRACE", "RBRACE",
 		"COMMA", "DOT", "COLON", "ELLIPSIS", "IDENTIFIER", "NUMBER", "STRING",
-		"ESC_SEQ", "COMMENT", "BLOCK_COMMENT", "WS",
+		"ESC_SEQ", "COMMENT", "BLOCK_COMMENT", "WS", "TRY", "THROW", "CATCH",
+		"FINALLY",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 0, 59, 400, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
+		4, 0, 63, 432, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
 		4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2,
 		10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15,
 		7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7,
@@ -112,144 +114,158 @@ func inscriptlexerLexerInit() {
 		57, 1, 57, 5, 57, 373, 8, 57, 10, 57, 12, 57, 376, 9, 57, 1, 57, 1, 57,
 		1, 58, 1, 58, 1, 58, 1, 58, 5, 58, 384, 8, 58, 10, 58, 12, 58, 387, 9,
 		58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 59, 4, 59, 395, 8, 59, 11, 59,
-		12, 59, 396, 1, 59, 1, 59, 3, 346, 359, 385, 0, 60, 1, 1, 3, 2, 5, 3, 7,
-		4, 9, 5, 11, 6, 13, 7, 15, 8, 17, 9, 19, 10, 21, 11, 23, 12, 25, 13, 27,
-		14, 29, 15, 31, 16, 33, 17, 35, 18, 37, 19, 39, 20, 41, 21, 43, 22, 45,
-		23, 47, 24, 49, 25, 51, 26, 53, 27, 55, 28, 57, 29, 59, 30, 61, 31, 63,
-		32, 65, 33, 67, 34, 69, 35, 71, 36, 73, 37, 75, 38, 77, 39, 79, 40, 81,
-		41, 83, 42, 85, 43, 87, 44, 89, 45, 91, 46, 93, 47, 95, 48, 97, 49, 99,
-		50, 101, 51, 103, 52, 105, 53, 107, 54, 109, 55, 111, 56, 113, 0, 115,
-		57, 117, 58, 119, 59, 1, 0, 8, 3, 0, 65, 90, 95, 95, 97, 122, 4, 0, 48,
-		57, 65, 90, 95, 95, 97, 122, 1, 0, 48, 57, 4, 0, 10, 10, 13, 13, 34, 34,
-		92, 92, 4, 0, 10, 10, 13, 13, 39, 39, 92, 92, 7, 0, 34, 34, 39, 39, 92,
-		92, 98, 98, 110, 110, 114, 114, 116, 116, 2, 0, 10, 10, 13, 13, 3, 0, 9,
-		10, 13, 13, 32, 32, 414, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0, 0, 5, 1, 0,
-		0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1, 0, 0, 0, 0, 13, 1,
-		0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1, 0, 0, 0, 0, 21,
-		1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0, 0, 0, 0,
-		29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1, 0, 0, 0,
-		0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43, 1, 0, 0,
-		0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0, 51, 1, 0,
-		0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0, 0, 59, 1,
-		0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0, 67,
-		1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0, 0,
-		75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1, 0, 0, 0,
-		0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89, 1, 0, 0,
-		0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1, 0,
-		0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0, 0, 0, 105,
-		1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0, 0, 111, 1, 0, 0, 0,
-		0, 115, 1, 0, 0, 0, 0, 117, 1, 0, 0, 0, 0, 119, 1, 0, 0, 0, 1, 121, 1,
-		0, 0, 0, 3, 130, 1, 0, 0, 0, 5, 133, 1, 0, 0, 0, 7, 138, 1, 0, 0, 0, 9,
-		144, 1, 0, 0, 0, 11, 148, 1, 0, 0, 0, 13, 151, 1, 0, 0, 0, 15, 157, 1,
-		0, 0, 0, 17, 166, 1, 0, 0, 0, 19, 173, 1, 0, 0, 0, 21, 180, 1, 0, 0, 0,
-		23, 186, 1, 0, 0, 0, 25, 191, 1, 0, 0, 0, 27, 197, 1, 0, 0, 0, 29, 201,
-		1, 0, 0, 0, 31, 205, 1, 0, 0, 0, 33, 208, 1, 0, 0, 0, 35, 212, 1, 0, 0,
-		0, 37, 215, 1, 0, 0, 0, 39, 217, 1, 0, 0, 0, 41, 219, 1, 0, 0, 0, 43, 221,
-		1, 0, 0, 0, 45, 223, 1, 0, 0, 0, 47, 226, 1, 0, 0, 0, 49, 228, 1, 0, 0,
-		0, 51, 230, 1, 0, 0, 0, 53, 232, 1, 0, 0, 0, 55, 234, 1, 0, 0, 0, 57, 236,
-		1, 0, 0, 0, 59, 239, 1, 0, 0, 0, 61, 242, 1, 0, 0, 0, 63, 245, 1, 0, 0,
-		0, 65, 248, 1, 0, 0, 0, 67, 250, 1, 0, 0, 0, 69, 253, 1, 0, 0, 0, 71, 255,
-		1, 0, 0, 0, 73, 258, 1, 0, 0, 0, 75, 260, 1, 0, 0, 0, 77, 263, 1, 0, 0,
-		0, 79, 266, 1, 0, 0, 0, 81, 269, 1, 0, 0, 0, 83, 272, 1, 0, 0, 0, 85, 276,
-		1, 0, 0, 0, 87, 279, 1, 0, 0, 0, 89, 281, 1, 0, 0, 0, 91, 283, 1, 0, 0,
-		0, 93, 285, 1, 0, 0, 0, 95, 287, 1, 0, 0, 0, 97, 289, 1, 0, 0, 0, 99, 291,
-		1, 0, 0, 0, 101, 293, 1, 0, 0, 0, 103, 295, 1, 0, 0, 0, 105, 297, 1, 0,
-		0, 0, 107, 301, 1, 0, 0, 0, 109, 309, 1, 0, 0, 0, 111, 365, 1, 0, 0, 0,
-		113, 367, 1, 0, 0, 0, 115, 370, 1, 0, 0, 0, 117, 379, 1, 0, 0, 0, 119,
-		394, 1, 0, 0, 0, 121, 122, 5, 102, 0, 0, 122, 123, 5, 117, 0, 0, 123, 124,
-		5, 110, 0, 0, 124, 125, 5, 99, 0, 0, 125, 126, 5, 116, 0, 0, 126, 127,
-		5, 105, 0, 0, 127, 128, 5, 111, 0, 0, 128, 129, 5, 110, 0, 0, 129, 2, 1,
-		0, 0, 0, 130, 131, 5, 105, 0, 0, 131, 132, 5, 102, 0, 0, 132, 4, 1, 0,
-		0, 0, 133, 134, 5, 101, 0, 0, 134, 135, 5, 108, 0, 0, 135, 136, 5, 115,
-		0, 0, 136, 137, 5, 101, 0, 0, 137, 6, 1, 0, 0, 0, 138, 139, 5, 119, 0,
-		0, 139, 140, 5, 104, 0, 0, 140, 141, 5, 105, 0, 0, 141, 142, 5, 108, 0,
-		0, 142, 143, 5, 101, 0, 0, 143, 8, 1, 0, 0, 0, 144, 145, 5, 102, 0, 0,
-		145, 146, 5, 111, 0, 0, 146, 147, 5, 114, 0, 0, 147, 10, 1, 0, 0, 0, 148,
-		149, 5, 105, 0, 0, 149, 150, 5, 110, 0, 0, 150, 12, 1, 0, 0, 0, 151, 152,
-		5, 98, 0, 0, 152, 153, 5, 114, 0, 0, 153, 154, 5, 101, 0, 0, 154, 155,
-		5, 97, 0, 0, 155, 156, 5, 107, 0, 0, 156, 14, 1, 0, 0, 0, 157, 158, 5,
-		99, 0, 0, 158, 159, 5, 111, 0, 0, 159, 160, 5, 110, 0, 0, 160, 161, 5,
-		116, 0, 0, 161, 162, 5, 105, 0, 0, 162, 163, 5, 110, 0, 0, 163, 164, 5,
-		117, 0, 0, 164, 165, 5, 101, 0, 0, 165, 16, 1, 0, 0, 0, 166, 167, 5, 114,
-		0, 0, 167, 168, 5, 101, 0, 0, 168, 169, 5, 116, 0, 0, 169, 170, 5, 117,
-		0, 0, 170, 171, 5, 114, 0, 0, 171, 172, 5, 110, 0, 0, 172, 18, 1, 0, 0,
-		0, 173, 174, 5, 105, 0, 0, 174, 175, 5, 109, 0, 0, 175, 176, 5, 112, 0,
-		0, 176, 177, 5, 111, 0, 0, 177, 178, 5, 114, 0, 0, 178, 179, 5, 116, 0,
-		0, 179, 20, 1, 0, 0, 0, 180, 181, 5, 112, 0, 0, 181, 182, 5, 114, 0, 0,
-		182, 183, 5, 105, 0, 0, 183, 184, 5, 110, 0, 0, 184, 185, 5, 116, 0, 0,
-		185, 22, 1, 0, 0, 0, 186, 187, 5, 116, 0, 0, 187, 188, 5, 114, 0, 0, 188,
-		189, 5, 117, 0, 0, 189, 190, 5, 101, 0, 0, 190, 24, 1, 0, 0, 0, 191, 192,
-		5, 102, 0, 0, 192, 193, 5, 97, 0, 0, 193, 194, 5, 108, 0, 0, 194, 195,
-		5, 115, 0, 0, 195, 196, 5, 101, 0, 0, 196, 26, 1, 0, 0, 0, 197, 198, 5,
-		110, 0, 0, 198, 199, 5, 105, 0, 0, 199, 200, 5, 108, 0, 0, 200, 28, 1,
-		0, 0, 0, 201, 202, 5, 97, 0, 0, 202, 203, 5, 110, 0, 0, 203, 204, 5, 100,
-		0, 0, 204, 30, 1, 0, 0, 0, 205, 206, 5, 111, 0, 0, 206, 207, 5, 114, 0,
-		0, 207, 32, 1, 0, 0, 0, 208, 209, 5, 110, 0, 0, 209, 210, 5, 111, 0, 0,
-		210, 211, 5, 116, 0, 0, 211, 34, 1, 0, 0, 0, 212, 213, 5, 94, 0, 0, 213,
-		214, 5, 94, 0, 0, 214, 36, 1, 0, 0, 0, 215, 216, 5, 43, 0, 0, 216, 38,
-		1, 0, 0, 0, 217, 218, 5, 45, 0, 0, 218, 40, 1, 0, 0, 0, 219, 220, 5, 42,
-		0, 0, 220, 42, 1, 0, 0, 0, 221, 222, 5, 47, 0, 0, 222, 44, 1, 0, 0, 0,
-		223, 224, 5, 47, 0, 0, 224, 225, 5, 47, 0, 0, 225, 46, 1, 0, 0, 0, 226,
-		227, 5, 37, 0, 0, 227, 48, 1, 0, 0, 0, 228, 229, 5, 38, 0, 0, 229, 50,
-		1, 0, 0, 0, 230, 231, 5, 124, 0, 0, 231, 52, 1, 0, 0, 0, 232, 233, 5, 94,
-		0, 0, 233, 54, 1, 0, 0, 0, 234, 235, 5, 126, 0, 0, 235, 56, 1, 0, 0, 0,
-		236, 237, 5, 60, 0, 0, 237, 238, 5, 60, 0, 0, 238, 58, 1, 0, 0, 0, 239,
-		240, 5, 62, 0, 0, 240, 241, 5, 62, 0, 0, 241, 60, 1, 0, 0, 0, 242, 243,
-		5, 61, 0, 0, 243, 244, 5, 61, 0, 0, 244, 62, 1, 0, 0, 0, 245, 246, 5, 33,
-		0, 0, 246, 247, 5, 61, 0, 0, 247, 64, 1, 0, 0, 0, 248, 249, 5, 60, 0, 0,
-		249, 66, 1, 0, 0, 0, 250, 251, 5, 60, 0, 0, 251, 252, 5, 61, 0, 0, 252,
-		68, 1, 0, 0, 0, 253, 254, 5, 62, 0, 0, 254, 70, 1, 0, 0, 0, 255, 256, 5,
-		62, 0, 0, 256, 257, 5, 61, 0, 0, 257, 72, 1, 0, 0, 0, 258, 259, 5, 61,
-		0, 0, 259, 74, 1, 0, 0, 0, 260, 261, 5, 43, 0, 0, 261, 262, 5, 61, 0, 0,
-		262, 76, 1, 0, 0, 0, 263, 264, 5, 45, 0, 0, 264, 265, 5, 61, 0, 0, 265,
-		78, 1, 0, 0, 0, 266, 267, 5, 42, 0, 0, 267, 268, 5, 61, 0, 0, 268, 80,
-		1, 0, 0, 0, 269, 270, 5, 47, 0, 0, 270, 271, 5, 61, 0, 0, 271, 82, 1, 0,
-		0, 0, 272, 273, 5, 94, 0, 0, 273, 274, 5, 94, 0, 0, 274, 275, 5, 61, 0,
-		0, 275, 84, 1, 0, 0, 0, 276, 277, 5, 45, 0, 0, 277, 278, 5, 62, 0, 0, 278,
-		86, 1, 0, 0, 0, 279, 280, 5, 40, 0, 0, 280, 88, 1, 0, 0, 0, 281, 282, 5,
-		41, 0, 0, 282, 90, 1, 0, 0, 0, 283, 284, 5, 91, 0, 0, 284, 92, 1, 0, 0,
-		0, 285, 286, 5, 93, 0, 0, 286, 94, 1, 0, 0, 0, 287, 288, 5, 123, 0, 0,
-		288, 96, 1, 0, 0, 0, 289, 290, 5, 125, 0, 0, 290, 98, 1, 0, 0, 0, 291,
-		292, 5, 44, 0, 0, 292, 100, 1, 0, 0, 0, 293, 294, 5, 46, 0, 0, 294, 102,
-		1, 0, 0, 0, 295, 296, 5, 58, 0, 0, 296, 104, 1, 0, 0, 0, 297, 298, 5, 46,
-		0, 0, 298, 299, 5, 46, 0, 0, 299, 300, 5, 46, 0, 0, 300, 106, 1, 0, 0,
-		0, 301, 305, 7, 0, 0, 0, 302, 304, 7, 1, 0, 0, 303, 302, 1, 0, 0, 0, 304,
-		307, 1, 0, 0, 0, 305, 303, 1, 0, 0, 0, 305, 306, 1, 0, 0, 0, 306, 108,
-		1, 0, 0, 0, 307, 305, 1, 0, 0, 0, 308, 310, 7, 2, 0, 0, 309, 308, 1, 0,
-		0, 0, 310, 311, 1, 0, 0, 0, 311, 309, 1, 0, 0, 0, 311, 312, 1, 0, 0, 0,
-		312, 319, 1, 0, 0, 0, 313, 315, 5, 46, 0, 0, 314, 316, 7, 2, 0, 0, 315,
-		314, 1, 0, 0, 0, 316, 317, 1, 0, 0, 0, 317, 315, 1, 0, 0, 0, 317, 318,
-		1, 0, 0, 0, 318, 320, 1, 0, 0, 0, 319, 313, 1, 0, 0, 0, 319, 320, 1, 0,
-		0, 0, 320, 110, 1, 0, 0, 0, 321, 326, 5, 34, 0, 0, 322, 325, 3, 113, 56,
-		0, 323, 325, 8, 3, 0, 0, 324, 322, 1, 0, 0, 0, 324, 323, 1, 0, 0, 0, 325,
-		328, 1, 0, 0, 0, 326, 324, 1, 0, 0, 0, 326, 327, 1, 0, 0, 0, 327, 329,
-		1, 0, 0, 0, 328, 326, 1, 0, 0, 0, 329, 366, 5, 34, 0, 0, 330, 335, 5, 39,
-		0, 0, 331, 334, 3, 113, 56, 0, 332, 334, 8, 4, 0, 0, 333, 331, 1, 0, 0,
-		0, 333, 332, 1, 0, 0, 0, 334, 337, 1, 0, 0, 0, 335, 333, 1, 0, 0, 0, 335,
-		336, 1, 0, 0, 0, 336, 338, 1, 0, 0, 0, 337, 335, 1, 0, 0, 0, 338, 366,
-		5, 39, 0, 0, 339, 340, 5, 34, 0, 0, 340, 341, 5, 34, 0, 0, 341, 342, 5,
-		34, 0, 0, 342, 346, 1, 0, 0, 0, 343, 345, 9, 0, 0, 0, 344, 343, 1, 0, 0,
-		0, 345, 348, 1, 0, 0, 0, 346, 347, 1, 0, 0, 0, 346, 344, 1, 0, 0, 0, 347,
-		349, 1, 0, 0, 0, 348, 346, 1, 0, 0, 0, 349, 350, 5, 34, 0, 0, 350, 351,
-		5, 34, 0, 0, 351, 366, 5, 34, 0, 0, 352, 353, 5, 39, 0, 0, 353, 354, 5,
-		39, 0, 0, 354, 355, 5, 39, 0, 0, 355, 359, 1, 0, 0, 0, 356, 358, 9, 0,
-		0, 0, 357, 356, 1, 0, 0, 0, 358, 361, 1, 0, 0, 0, 359, 360, 1, 0, 0, 0,
-		359, 357, 1, 0, 0, 0, 360, 362, 1, 0, 0, 0, 361, 359, 1, 0, 0, 0, 362,
-		363, 5, 39, 0, 0, 363, 364, 5, 39, 0, 0, 364, 366, 5, 39, 0, 0, 365, 321,
-		1, 0, 0, 0, 365, 330, 1, 0, 0, 0, 365, 339, 1, 0, 0, 0, 365, 352, 1, 0,
-		0, 0, 366, 112, 1, 0, 0, 0, 367, 368, 5, 92, 0, 0, 368, 369, 7, 5, 0, 0,
-		369, 114, 1, 0, 0, 0, 370, 374, 5, 35, 0, 0, 371, 373, 8, 6, 0, 0, 372,
-		371, 1, 0, 0, 0, 373, 376, 1, 0, 0, 0, 374, 372, 1, 0, 0, 0, 374, 375,
-		1, 0, 0, 0, 375, 377, 1, 0, 0, 0, 376, 374, 1, 0, 0, 0, 377, 378, 6, 57,
-		0, 0, 378, 116, 1, 0, 0, 0, 379, 380, 5, 47, 0, 0, 380, 381, 5, 42, 0,
-		0, 381, 385, 1, 0, 0, 0, 382, 384, 9, 0, 0, 0, 383, 382, 1, 0, 0, 0, 384,
-		387, 1, 0, 0, 0, 385, 386, 1, 0, 0, 0, 385, 383, 1, 0, 0, 0, 386, 388,
-		1, 0, 0, 0, 387, 385, 1, 0, 0, 0, 388, 389, 5, 42, 0, 0, 389, 390, 5, 47,
-		0, 0, 390, 391, 1, 0, 0, 0, 391, 392, 6, 58, 0, 0, 392, 118, 1, 0, 0, 0,
-		393, 395, 7, 7, 0, 0, 394, 393, 1, 0, 0, 0, 395, 396, 1, 0, 0, 0, 396,
-		394, 1, 0, 0, 0, 396, 397, 1, 0, 0, 0, 397, 398, 1, 0, 0, 0, 398, 399,
-		6, 59, 0, 0, 399, 120, 1, 0, 0, 0, 15, 0, 305, 311, 317, 319, 324, 326,
-		333, 335, 346, 359, 365, 374, 385, 396, 1, 6, 0, 0,
+		12, 59, 396, 1, 59, 1, 59, 2, 60, 7, 60, 1, 60, 1, 60, 1, 60, 1, 60, 2,
+		61, 7, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 2, 62, 7, 62, 1, 62,
+		1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 2, 63, 7, 63, 1, 63, 1, 63, 1, 63, 1,
+		63, 1, 63, 1, 63, 1, 63, 1, 63, 3, 346, 359, 385, 0, 64, 1, 1, 3, 2, 5,
+		3, 7, 4, 9, 5, 11, 6, 13, 7, 15, 8, 17, 9, 19, 10, 21, 11, 23, 12, 25,
+		13, 27, 14, 29, 15, 31, 16, 33, 17, 35, 18, 37, 19, 39, 20, 41, 21, 43,
+		22, 45, 23, 47, 24, 49, 25, 51, 26, 53, 27, 55, 28, 57, 29, 59, 30, 61,
+		31, 63, 32, 65, 33, 67, 34, 69, 35, 71, 36, 73, 37, 75, 38, 77, 39, 79,
+		40, 81, 41, 83, 42, 85, 43, 87, 44, 89, 45, 91, 46, 93, 47, 95, 48, 97,
+		49, 99, 50, 101, 51, 103, 52, 105, 53, 107, 54, 109, 55, 111, 56, 113,
+		0, 115, 57, 117, 58, 119, 59, 400, 60, 406, 61, 414, 62, 422, 63, 1, 0,
+		8, 3, 0, 65, 90, 95, 95, 97, 122, 4, 0, 48, 57, 65, 90, 95, 95, 97, 122,
+		1, 0, 48, 57, 4, 0, 10, 10, 13, 13, 34, 34, 92, 92, 4, 0, 10, 10, 13, 13,
+		39, 39, 92, 92, 7, 0, 34, 34, 39, 39, 92, 92, 98, 98, 110, 110, 114, 114,
+		116, 116, 2, 0, 10, 10, 13, 13, 3, 0, 9, 10, 13, 13, 32, 32, 446, 0, 1,
+		1, 0, 0, 0, 0, 3, 1, 0, 0, 0, 0, 5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 9,
+		1, 0, 0, 0, 0, 11, 1, 0, 0, 0, 0, 13, 1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0,
+		17, 1, 0, 0, 0, 0, 19, 1, 0, 0, 0, 0, 21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0,
+		0, 25, 1, 0, 0, 0, 0, 27, 1, 0, 0, 0, 0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0,
+		0, 0, 33, 1, 0, 0, 0, 0, 35, 1, 0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0,
+		0, 0, 0, 41, 1, 0, 0, 0, 0, 43, 1, 0, 0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1,
+		0, 0, 0, 0, 49, 1, 0, 0, 0, 0, 51, 1, 0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55,
+		1, 0, 0, 0, 0, 57, 1, 0, 0, 0, 0, 59, 1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0,
+		63, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0, 67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0,
+		0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0, 0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0,
+		0, 0, 79, 1, 0, 0, 0, 0, 81, 1, 0, 0, 0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0,
+		0, 0, 0, 87, 1, 0, 0, 0, 0, 89, 1, 0, 0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1,
+		0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1, 0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101,
+		1, 0, 0, 0, 0, 103, 1, 0, 0, 0, 0, 105, 1, 0, 0, 0, 0, 400, 1, 0, 0, 0,
+		0, 406, 1, 0, 0, 0, 0, 414, 1, 0, 0, 0, 0, 422, 1, 0, 0, 0, 0, 107, 1,
+		0, 0, 0, 0, 109, 1, 0, 0, 0, 0, 111, 1, 0, 0, 0, 0, 115, 1, 0, 0, 0, 0,
+		117, 1, 0, 0, 0, 0, 119, 1, 0, 0, 0, 1, 121, 1, 0, 0, 0, 3, 130, 1, 0,
+		0, 0, 5, 133, 1, 0, 0, 0, 7, 138, 1, 0, 0, 0, 9, 144, 1, 0, 0, 0, 11, 148,
+		1, 0, 0, 0, 13, 151, 1, 0, 0, 0, 15, 157, 1, 0, 0, 0, 17, 166, 1, 0, 0,
+		0, 19, 173, 1, 0, 0, 0, 21, 180, 1, 0, 0, 0, 23, 186, 1, 0, 0, 0, 25, 191,
+		1, 0, 0, 0, 27, 197, 1, 0, 0, 0, 29, 201, 1, 0, 0, 0, 31, 205, 1, 0, 0,
+		0, 33, 208, 1, 0, 0, 0, 35, 212, 1, 0, 0, 0, 37, 215, 1, 0, 0, 0, 39, 217,
+		1, 0, 0, 0, 41, 219, 1, 0, 0, 0, 43, 221, 1, 0, 0, 0, 45, 223, 1, 0, 0,
+		0, 47, 226, 1, 0, 0, 0, 49, 228, 1, 0, 0, 0, 51, 230, 1, 0, 0, 0, 53, 232,
+		1, 0, 0, 0, 55, 234, 1, 0, 0, 0, 57, 236, 1, 0, 0, 0, 59, 239, 1, 0, 0,
+		0, 61, 242, 1, 0, 0, 0, 63, 245, 1, 0, 0, 0, 65, 248, 1, 0, 0, 0, 67, 250,
+		1, 0, 0, 0, 69, 253, 1, 0, 0, 0, 71, 255, 1, 0, 0, 0, 73, 258, 1, 0, 0,
+		0, 75, 260, 1, 0, 0, 0, 77, 263, 1, 0, 0, 0, 79, 266, 1, 0, 0, 0, 81, 269,
+		1, 0, 0, 0, 83, 272, 1, 0, 0, 0, 85, 276, 1, 0, 0, 0, 87, 279, 1, 0, 0,
+		0, 89, 281, 1, 0, 0, 0, 91, 283, 1, 0, 0, 0, 93, 285, 1, 0, 0, 0, 95, 287,
+		1, 0, 0, 0, 97, 289, 1, 0, 0, 0, 99, 291, 1, 0, 0, 0, 101, 293, 1, 0, 0,
+		0, 103, 295, 1, 0, 0, 0, 105, 297, 1, 0, 0, 0, 107, 301, 1, 0, 0, 0, 109,
+		309, 1, 0, 0, 0, 111, 365, 1, 0, 0, 0, 113, 367, 1, 0, 0, 0, 115, 370,
+		1, 0, 0, 0, 117, 379, 1, 0, 0, 0, 119, 394, 1, 0, 0, 0, 121, 122, 5, 102,
+		0, 0, 122, 123, 5, 117, 0, 0, 123, 124, 5, 110, 0, 0, 124, 125, 5, 99,
+		0, 0, 125, 126, 5, 116, 0, 0, 126, 127, 5, 105, 0, 0, 127, 128, 5, 111,
+		0, 0, 128, 129, 5, 110, 0, 0, 129, 2, 1, 0, 0, 0, 130, 131, 5, 105, 0,
+		0, 131, 132, 5, 102, 0, 0, 132, 4, 1, 0, 0, 0, 133, 134, 5, 101, 0, 0,
+		134, 135, 5, 108, 0, 0, 135, 136, 5, 115, 0, 0, 136, 137, 5, 101, 0, 0,
+		137, 6, 1, 0, 0, 0, 138, 139, 5, 119, 0, 0, 139, 140, 5, 104, 0, 0, 140,
+		141, 5, 105, 0, 0, 141, 142, 5, 108, 0, 0, 142, 143, 5, 101, 0, 0, 143,
+		8, 1, 0, 0, 0, 144, 145, 5, 102, 0, 0, 145, 146, 5, 111, 0, 0, 146, 147,
+		5, 114, 0, 0, 147, 10, 1, 0, 0, 0, 148, 149, 5, 105, 0, 0, 149, 150, 5,
+		110, 0, 0, 150, 12, 1, 0, 0, 0, 151, 152, 5, 98, 0, 0, 152, 153, 5, 114,
+		0, 0, 153, 154, 5, 101, 0, 0, 154, 155, 5, 97, 0, 0, 155, 156, 5, 107,
+		0, 0, 156, 14, 1, 0, 0, 0, 157, 158, 5, 99, 0, 0, 158, 159, 5, 111, 0,
+		0, 159, 160, 5, 110, 0, 0, 160, 161, 5, 116, 0, 0, 161, 162, 5, 105, 0,
+		0, 162, 163, 5, 110, 0, 0, 163, 164, 5, 117, 0, 0, 164, 165, 5, 101, 0,
+		0, 165, 16, 1, 0, 0, 0, 166, 167, 5, 114, 0, 0, 167, 168, 5, 101, 0, 0,
+		168, 169, 5, 116, 0, 0, 169, 170, 5, 117, 0, 0, 170, 171, 5, 114, 0, 0,
+		171, 172, 5, 110, 0, 0, 172, 18, 1, 0, 0, 0, 173, 174, 5, 105, 0, 0, 174,
+		175, 5, 109, 0, 0, 175, 176, 5, 112, 0, 0, 176, 177, 5, 111, 0, 0, 177,
+		178, 5, 114, 0, 0, 178, 179, 5, 116, 0, 0, 179, 20, 1, 0, 0, 0, 180, 181,
+		5, 112, 0, 0, 181, 182, 5, 114, 0, 0, 182, 183, 5, 105, 0, 0, 183, 184,
+		5, 110, 0, 0, 184, 185, 5, 116, 0, 0, 185, 22, 1, 0, 0, 0, 186, 187, 5,
+		116, 0, 0, 187, 188, 5, 114, 0, 0, 188, 189, 5, 117, 0, 0, 189, 190, 5,
+		101, 0, 0, 190, 24, 1, 0, 0, 0, 191, 192, 5, 102, 0, 0, 192, 193, 5, 97,
+		0, 0, 193, 194, 5, 108, 0, 0, 194, 195, 5, 115, 0, 0, 195, 196, 5, 101,
+		0, 0, 196, 26, 1, 0, 0, 0, 197, 198, 5, 110, 0, 0, 198, 199, 5, 105, 0,
+		0, 199, 200, 5, 108, 0, 0, 200, 28, 1, 0, 0, 0, 201, 202, 5, 97, 0, 0,
+		202, 203, 5, 110, 0, 0, 203, 204, 5, 100, 0, 0, 204, 30, 1, 0, 0, 0, 205,
+		206, 5, 111, 0, 0, 206, 207, 5, 114, 0, 0, 207, 32, 1, 0, 0, 0, 208, 209,
+		5, 110, 0, 0, 209, 210, 5, 111, 0, 0, 210, 211, 5, 116, 0, 0, 211, 34,
+		1, 0, 0, 0, 212, 213, 5, 94, 0, 0, 213, 214, 5, 94, 0, 0, 214, 36, 1, 0,
+		0, 0, 215, 216, 5, 43, 0, 0, 216, 38, 1, 0, 0, 0, 217, 218, 5, 45, 0, 0,
+		218, 40, 1, 0, 0, 0, 219, 220, 5, 42, 0, 0, 220, 42, 1, 0, 0, 0, 221, 222,
+		5, 47, 0, 0, 222, 44, 1, 0, 0, 0, 223, 224, 5, 47, 0, 0, 224, 225, 5, 47,
+		0, 0, 225, 46, 1, 0, 0, 0, 226, 227, 5, 37, 0, 0, 227, 48, 1, 0, 0, 0,
+		228, 229, 5, 38, 0, 0, 229, 50, 1, 0, 0, 0, 230, 231, 5, 124, 0, 0, 231,
+		52, 1, 0, 0, 0, 232, 233, 5, 94, 0, 0, 233, 54, 1, 0, 0, 0, 234, 235, 5,
+		126, 0, 0, 235, 56, 1, 0, 0, 0, 236, 237, 5, 60, 0, 0, 237, 238, 5, 60,
+		0, 0, 238, 58, 1, 0, 0, 0, 239, 240, 5, 62, 0, 0, 240, 241, 5, 62, 0, 0,
+		241, 60, 1, 0, 0, 0, 242, 243, 5, 61, 0, 0, 243, 244, 5, 61, 0, 0, 244,
+		62, 1, 0, 0, 0, 245, 246, 5, 33, 0, 0, 246, 247, 5, 61, 0, 0, 247, 64,
+		1, 0, 0, 0, 248, 249, 5, 60, 0, 0, 249, 66, 1, 0, 0, 0, 250, 251, 5, 60,
+		0, 0, 251, 252, 5, 61, 0, 0, 252, 68, 1, 0, 0, 0, 253, 254, 5, 62, 0, 0,
+		254, 70, 1, 0, 0, 0, 255, 256, 5, 62, 0, 0, 256, 257, 5, 61, 0, 0, 257,
+		72, 1, 0, 0, 0, 258, 259, 5, 61, 0, 0, 259, 74, 1, 0, 0, 0, 260, 261, 5,
+		43, 0, 0, 261, 262, 5, 61, 0, 0, 262, 76, 1, 0, 0, 0, 263, 264, 5, 45,
+		0, 0, 264, 265, 5, 61, 0, 0, 265, 78, 1, 0, 0, 0, 266, 267, 5, 42, 0, 0,
+		267, 268, 5, 61, 0, 0, 268, 80, 1, 0, 0, 0, 269, 270, 5, 47, 0, 0, 270,
+		271, 5, 61, 0, 0, 271, 82, 1, 0, 0, 0, 272, 273, 5, 94, 0, 0, 273, 274,
+		5, 94, 0, 0, 274, 275, 5, 61, 0, 0, 275, 84, 1, 0, 0, 0, 276, 277, 5, 45,
+		0, 0, 277, 278, 5, 62, 0, 0, 278, 86, 1, 0, 0, 0, 279, 280, 5, 40, 0, 0,
+		280, 88, 1, 0, 0, 0, 281, 282, 5, 41, 0, 0, 282, 90, 1, 0, 0, 0, 283, 284,
+		5, 91, 0, 0, 284, 92, 1, 0, 0, 0, 285, 286, 5, 93, 0, 0, 286, 94, 1, 0,
+		0, 0, 287, 288, 5, 123, 0, 0, 288, 96, 1, 0, 0, 0, 289, 290, 5, 125, 0,
+		0, 290, 98, 1, 0, 0, 0, 291, 292, 5, 44, 0, 0, 292, 100, 1, 0, 0, 0, 293,
+		294, 5, 46, 0, 0, 294, 102, 1, 0, 0, 0, 295, 296, 5, 58, 0, 0, 296, 104,
+		1, 0, 0, 0, 297, 298, 5, 46, 0, 0, 298, 299, 5, 46, 0, 0, 299, 300, 5,
+		46, 0, 0, 300, 106, 1, 0, 0, 0, 301, 305, 7, 0, 0, 0, 302, 304, 7, 1, 0,
+		0, 303, 302, 1, 0, 0, 0, 304, 307, 1, 0, 0, 0, 305, 303, 1, 0, 0, 0, 305,
+		306, 1, 0, 0, 0, 306, 108, 1, 0, 0, 0, 307, 305, 1, 0, 0, 0, 308, 310,
+		7, 2, 0, 0, 309, 308, 1, 0, 0, 0, 310, 311, 1, 0, 0, 0, 311, 309, 1, 0,
+		0, 0, 311, 312, 1, 0, 0, 0, 312, 319, 1, 0, 0, 0, 313, 315, 5, 46, 0, 0,
+		314, 316, 7, 2, 0, 0, 315, 314, 1, 0, 0, 0, 316, 317, 1, 0, 0, 0, 317,
+		315, 1, 0, 0, 0, 317, 318, 1, 0, 0, 0, 318, 320, 1, 0, 0, 0, 319, 313,
+		1, 0, 0, 0, 319, 320, 1, 0, 0, 0, 320, 110, 1, 0, 0, 0, 321, 326, 5, 34,
+		0, 0, 322, 325, 3, 113, 56, 0, 323, 325, 8, 3, 0, 0, 324, 322, 1, 0, 0,
+		0, 324, 323, 1, 0, 0, 0, 325, 328, 1, 0, 0, 0, 326, 324, 1, 0, 0, 0, 326,
+		327, 1, 0, 0, 0, 327, 329, 1, 0, 0, 0, 328, 326, 1, 0, 0, 0, 329, 366,
+		5, 34, 0, 0, 330, 335, 5, 39, 0, 0, 331, 334, 3, 113, 56, 0, 332, 334,
+		8, 4, 0, 0, 333, 331, 1, 0, 0, 0, 333, 332, 1, 0, 0, 0, 334, 337, 1, 0,
+		0, 0, 335, 333, 1, 0, 0, 0, 335, 336, 1, 0, 0, 0, 336, 338, 1, 0, 0, 0,
+		337, 335, 1, 0, 0, 0, 338, 366, 5, 39, 0, 0, 339, 340, 5, 34, 0, 0, 340,
+		341, 5, 34, 0, 0, 341, 342, 5, 34, 0, 0, 342, 346, 1, 0, 0, 0, 343, 345,
+		9, 0, 0, 0, 344, 343, 1, 0, 0, 0, 345, 348, 1, 0, 0, 0, 346, 347, 1, 0,
+		0, 0, 346, 344, 1, 0, 0, 0, 347, 349, 1, 0, 0, 0, 348, 346, 1, 0, 0, 0,
+		349, 350, 5, 34, 0, 0, 350, 351, 5, 34, 0, 0, 351, 366, 5, 34, 0, 0, 352,
+		353, 5, 39, 0, 0, 353, 354, 5, 39, 0, 0, 354, 355, 5, 39, 0, 0, 355, 359,
+		1, 0, 0, 0, 356, 358, 9, 0, 0, 0, 357, 356, 1, 0, 0, 0, 358, 361, 1, 0,
+		0, 0, 359, 360, 1, 0, 0, 0, 359, 357, 1, 0, 0, 0, 360, 362, 1, 0, 0, 0,
+		361, 359, 1, 0, 0, 0, 362, 363, 5, 39, 0, 0, 363, 364, 5, 39, 0, 0, 364,
+		366, 5, 39, 0, 0, 365, 321, 1, 0, 0, 0, 365, 330, 1, 0, 0, 0, 365, 339,
+		1, 0, 0, 0, 365, 352, 1, 0, 0, 0, 366, 112, 1, 0, 0, 0, 367, 368, 5, 92,
+		0, 0, 368, 369, 7, 5, 0, 0, 369, 114, 1, 0, 0, 0, 370, 374, 5, 35, 0, 0,
+		371, 373, 8, 6, 0, 0, 372, 371, 1, 0, 0, 0, 373, 376, 1, 0, 0, 0, 374,
+		372, 1, 0, 0, 0, 374, 375, 1, 0, 0, 0, 375, 377, 1, 0, 0, 0, 376, 374,
+		1, 0, 0, 0, 377, 378, 6, 57, 0, 0, 378, 116, 1, 0, 0, 0, 379, 380, 5, 47,
+		0, 0, 380, 381, 5, 42, 0, 0, 381, 385, 1, 0, 0, 0, 382, 384, 9, 0, 0, 0,
+		383, 382, 1, 0, 0, 0, 384, 387, 1, 0, 0, 0, 385, 386, 1, 0, 0, 0, 385,
+		383, 1, 0, 0, 0, 386, 388, 1, 0, 0, 0, 387, 385, 1, 0, 0, 0, 388, 389,
+		5, 42, 0, 0, 389, 390, 5, 47, 0, 0, 390, 391, 1, 0, 0, 0, 391, 392, 6,
+		58, 0, 0, 392, 118, 1, 0, 0, 0, 393, 395, 7, 7, 0, 0, 394, 393, 1, 0, 0,
+		0, 395, 396, 1, 0, 0, 0, 396, 394, 1, 0, 0, 0, 396, 397, 1, 0, 0, 0, 397,
+		398, 1, 0, 0, 0, 398, 399, 6, 59, 0, 0, 399, 120, 1, 0, 0, 0, 400, 402,
+		1, 0, 0, 0, 402, 403, 5, 116, 0, 0, 403, 404, 5, 114, 0, 0, 404, 405, 5,
+		121, 0, 0, 405, 401, 1, 0, 0, 0, 406, 408, 1, 0, 0, 0, 408, 409, 5, 116,
+		0, 0, 409, 410, 5, 104, 0, 0, 410, 411, 5, 114, 0, 0, 411, 412, 5, 111,
+		0, 0, 412, 413, 5, 119, 0, 0, 413, 407, 1, 0, 0, 0, 414, 416, 1, 0, 0,
+		0, 416, 417, 5, 99, 0, 0, 417, 418, 5, 97, 0, 0, 418, 419, 5, 116, 0, 0,
+		419, 420, 5, 99, 0, 0, 420, 421, 5, 104, 0, 0, 421, 415, 1, 0, 0, 0, 422,
+		424, 1, 0, 0, 0, 424, 425, 5, 102, 0, 0, 425, 426, 5, 105, 0, 0, 426, 427,
+		5, 110, 0, 0, 427, 428, 5, 97, 0, 0, 428, 429, 5, 108, 0, 0, 429, 430,
+		5, 108, 0, 0, 430, 431, 5, 121, 0, 0, 431, 423, 1, 0, 0, 0, 15, 0, 305,
+		311, 317, 319, 324, 326, 333, 335, 346, 359, 365, 374, 385, 396, 1, 6,
+		0, 0,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -349,4 +365,8 @@ const (
 	InscriptLexerCOMMENT       = 57
 	InscriptLexerBLOCK_COMMENT = 58
 	InscriptLexerWS            = 59
+	InscriptLexerTRY           = 60
+	InscriptLexerTHROW         = 61
+	InscriptLexerCATCH         = 62
+	InscriptLexerFINALLY       = 63
 )
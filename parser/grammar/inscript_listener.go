@@ -62,6 +62,18 @@ type InscriptListener interface {
 	// EnterPrintStmt is called when entering the printStmt production.
 	EnterPrintStmt(c *PrintStmtContext)
 
+	// EnterTryStmt is called when entering the tryStmt production.
+	EnterTryStmt(c *TryStmtContext)
+
+	// EnterThrowStmt is called when entering the throwStmt production.
+	EnterThrowStmt(c *ThrowStmtContext)
+
+	// EnterFunctionLiteral is called when entering the functionLiteral production.
+	EnterFunctionLiteral(c *FunctionLiteralContext)
+
+	// EnterArrowFunction is called when entering the arrowFunction production.
+	EnterArrowFunction(c *ArrowFunctionContext)
+
 	// EnterGeExpr is called when entering the geExpr production.
 	EnterGeExpr(c *GeExprContext)
 
@@ -152,6 +164,18 @@ type InscriptListener interface {
 	// EnterArgList is called when entering the argList production.
 	EnterArgList(c *ArgListContext)
 
+	// EnterArgument is called when entering the argument production.
+	EnterArgument(c *ArgumentContext)
+
+	// EnterTargetList is called when entering the targetList production.
+	EnterTargetList(c *TargetListContext)
+
+	// EnterTargetItem is called when entering the targetItem production.
+	EnterTargetItem(c *TargetItemContext)
+
+	// EnterExpressionList is called when entering the expressionList production.
+	EnterExpressionList(c *ExpressionListContext)
+
 	// EnterPrimary is called when entering the primary production.
 	EnterPrimary(c *PrimaryContext)
 
@@ -224,6 +248,18 @@ type InscriptListener interface {
 	// ExitPrintStmt is called when exiting the printStmt production.
 	ExitPrintStmt(c *PrintStmtContext)
 
+	// ExitTryStmt is called when exiting the tryStmt production.
+	ExitTryStmt(c *TryStmtContext)
+
+	// ExitThrowStmt is called when exiting the throwStmt production.
+	ExitThrowStmt(c *ThrowStmtContext)
+
+	// ExitFunctionLiteral is called when exiting the functionLiteral production.
+	ExitFunctionLiteral(c *FunctionLiteralContext)
+
+	// ExitArrowFunction is called when exiting the arrowFunction production.
+	ExitArrowFunction(c *ArrowFunctionContext)
+
 	// ExitGeExpr is called when exiting the geExpr production.
 	ExitGeExpr(c *GeExprContext)
 
@@ -314,6 +350,18 @@ type InscriptListener interface {
 	// ExitArgList is called when exiting the argList production.
 	ExitArgList(c *ArgListContext)
 
+	// ExitArgument is called when exiting the argument production.
+	ExitArgument(c *ArgumentContext)
+
+	// ExitTargetList is called when exiting the targetList production.
+	ExitTargetList(c *TargetListContext)
+
+	// ExitTargetItem is called when exiting the targetItem production.
+	ExitTargetItem(c *TargetItemContext)
+
+	// ExitExpressionList is called when exiting the expressionList production.
+	ExitExpressionList(c *ExpressionListContext)
+
 	// ExitPrimary is called when exiting the primary production.
 	ExitPrimary(c *PrimaryContext)
 
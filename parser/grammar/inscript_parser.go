@@ -39,7 +39,8 @@ func inscriptParserInit() {
 		"'//'", "'%'", "'&'", "'|'", "'^'", "'~'", "'<<'", "'>>'", "'=='", "'!='",
 		"'<'", "'<='", "'>'", "'>='", "'='", "'+='", "'-='", "'*='", "'/='",
 		"'^^='", "'->'", "'('", "')'", "'['", "']'", "'{'", "'}'", "','", "'.'",
-		"':'", "'...'",
+		"':'", "'...'", "", "", "", "", "", "", "'try'", "'throw'", "'catch'",
+		"'finally'",
 	}
 	staticData.SymbolicNames = []string{
 		"", "FUNCTION", "IF", "ELSE", "WHILE", "FOR", "IN", "BREAK", "CONTINUE",
@@ -49,18 +50,20 @@ func inscriptParserInit() {
 		"ASSIGN", "ADD_ASSIGN", "SUB_ASSIGN", "MUL_ASSIGN", "DIV_ASSIGN", "POW_ASSIGN",
 		"ARROW", "LPAREN", "RPAREN", "LBRACK", "RBRACK", "LBRACE", "RBRACE",
 		"COMMA", "DOT", "COLON", "ELLIPSIS", "IDENTIFIER", "NUMBER", "STRING",
-		"COMMENT", "BLOCK_COMMENT", "WS",
+		"COMMENT", "BLOCK_COMMENT", "WS", "TRY", "THROW", "CATCH", "FINALLY",
 	}
 	staticData.RuleNames = []string{
 		"program", "statement", "block", "exprStmt", "assignment", "target",
 		"ifStmt", "whileStmt", "forStmt", "funcDef", "paramList", "param", "typeAnnotation",
 		"breakStmt", "continueStmt", "returnStmt", "importStmt", "printStmt",
 		"expression", "unaryExpr", "postfixExpr", "argList", "primary", "literal",
-		"listLiteral", "tableLiteral", "tableKeyValue", "tableKey",
+		"listLiteral", "tableLiteral", "tableKeyValue", "tableKey", "tryStmt",
+		"throwStmt", "functionLiteral", "arrowFunction", "argument", "targetList",
+		"targetItem", "expressionList",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 59, 351, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
+		4, 1, 63, 440, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
 		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
 		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
 		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
@@ -95,9 +98,19 @@ func inscriptParserInit() {
 		24, 320, 8, 24, 10, 24, 12, 24, 323, 9, 24, 3, 24, 325, 8, 24, 1, 24, 1,
 		24, 1, 25, 1, 25, 1, 25, 1, 25, 5, 25, 333, 8, 25, 10, 25, 12, 25, 336,
 		9, 25, 3, 25, 338, 8, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1,
-		27, 1, 27, 1, 27, 3, 27, 349, 8, 27, 1, 27, 0, 2, 36, 40, 28, 0, 2, 4,
-		6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42,
-		44, 46, 48, 50, 52, 54, 0, 2, 1, 0, 37, 42, 2, 0, 12, 14, 55, 56, 388,
+		27, 1, 27, 1, 27, 3, 27, 349, 8, 27, 1, 27, 2, 28, 7, 28, 2, 29, 7, 29,
+		1, 28, 1, 28, 8, 28, 1, 28, 1, 28, 1, 28, 8, 28, 1, 28, 1, 28, 3, 28, 361,
+		1, 28, 1, 28, 3, 28, 357, 1, 29, 1, 29, 1, 1, 1, 1, 2, 30, 7, 30, 2, 31,
+		7, 31, 1, 30, 1, 30, 1, 30, 1, 30, 8, 30, 1, 30, 1, 30, 3, 30, 380, 8,
+		30, 1, 30, 3, 30, 384, 1, 31, 1, 31, 8, 31, 1, 31, 1, 31, 1, 31, 8, 31,
+		1, 31, 3, 31, 393, 3, 31, 389, 1, 22, 1, 22, 2, 32, 7, 32, 8, 32, 1, 32,
+		1, 32, 1, 32, 1, 32, 3, 32, 401, 8, 6, 3, 6, 407, 1, 6, 2, 33, 7, 33, 2,
+		34, 7, 34, 2, 35, 7, 35, 1, 33, 8, 33, 1, 33, 1, 33, 5, 33, 417, 10, 33,
+		12, 33, 423, 9, 33, 8, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 3,
+		34, 424, 1, 35, 8, 35, 1, 35, 1, 35, 5, 35, 433, 10, 35, 12, 35, 439, 9,
+		35, 0, 2, 36, 40, 36, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26,
+		28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 351, 353, 372,
+		374, 399, 410, 412, 414, 0, 2, 1, 0, 37, 42, 2, 0, 12, 14, 55, 56, 485,
 		0, 59, 1, 0, 0, 0, 2, 76, 1, 0, 0, 0, 4, 78, 1, 0, 0, 0, 6, 87, 1, 0, 0,
 		0, 8, 89, 1, 0, 0, 0, 10, 103, 1, 0, 0, 0, 12, 105, 1, 0, 0, 0, 14, 112,
 		1, 0, 0, 0, 16, 116, 1, 0, 0, 0, 18, 122, 1, 0, 0, 0, 20, 135, 1, 0, 0,
@@ -115,95 +128,96 @@ func inscriptParserInit() {
 		1, 0, 0, 0, 76, 65, 1, 0, 0, 0, 76, 66, 1, 0, 0, 0, 76, 67, 1, 0, 0, 0,
 		76, 68, 1, 0, 0, 0, 76, 69, 1, 0, 0, 0, 76, 70, 1, 0, 0, 0, 76, 71, 1,
 		0, 0, 0, 76, 72, 1, 0, 0, 0, 76, 73, 1, 0, 0, 0, 76, 74, 1, 0, 0, 0, 76,
-		75, 1, 0, 0, 0, 77, 3, 1, 0, 0, 0, 78, 82, 5, 48, 0, 0, 79, 81, 3, 2, 1,
-		0, 80, 79, 1, 0, 0, 0, 81, 84, 1, 0, 0, 0, 82, 80, 1, 0, 0, 0, 82, 83,
-		1, 0, 0, 0, 83, 85, 1, 0, 0, 0, 84, 82, 1, 0, 0, 0, 85, 86, 5, 49, 0, 0,
-		86, 5, 1, 0, 0, 0, 87, 88, 3, 36, 18, 0, 88, 7, 1, 0, 0, 0, 89, 90, 3,
-		10, 5, 0, 90, 91, 7, 0, 0, 0, 91, 92, 3, 36, 18, 0, 92, 9, 1, 0, 0, 0,
-		93, 104, 5, 54, 0, 0, 94, 95, 3, 40, 20, 0, 95, 96, 5, 46, 0, 0, 96, 97,
-		3, 36, 18, 0, 97, 98, 5, 47, 0, 0, 98, 104, 1, 0, 0, 0, 99, 100, 3, 40,
-		20, 0, 100, 101, 5, 51, 0, 0, 101, 102, 5, 54, 0, 0, 102, 104, 1, 0, 0,
-		0, 103, 93, 1, 0, 0, 0, 103, 94, 1, 0, 0, 0, 103, 99, 1, 0, 0, 0, 104,
-		11, 1, 0, 0, 0, 105, 106, 5, 2, 0, 0, 106, 107, 3, 36, 18, 0, 107, 110,
-		3, 4, 2, 0, 108, 109, 5, 3, 0, 0, 109, 111, 3, 4, 2, 0, 110, 108, 1, 0,
-		0, 0, 110, 111, 1, 0, 0, 0, 111, 13, 1, 0, 0, 0, 112, 113, 5, 4, 0, 0,
-		113, 114, 3, 36, 18, 0, 114, 115, 3, 4, 2, 0, 115, 15, 1, 0, 0, 0, 116,
-		117, 5, 5, 0, 0, 117, 118, 5, 54, 0, 0, 118, 119, 5, 6, 0, 0, 119, 120,
-		3, 36, 18, 0, 120, 121, 3, 4, 2, 0, 121, 17, 1, 0, 0, 0, 122, 123, 5, 1,
-		0, 0, 123, 124, 5, 54, 0, 0, 124, 126, 5, 44, 0, 0, 125, 127, 3, 20, 10,
-		0, 126, 125, 1, 0, 0, 0, 126, 127, 1, 0, 0, 0, 127, 128, 1, 0, 0, 0, 128,
-		131, 5, 45, 0, 0, 129, 130, 5, 43, 0, 0, 130, 132, 3, 24, 12, 0, 131, 129,
-		1, 0, 0, 0, 131, 132, 1, 0, 0, 0, 132, 133, 1, 0, 0, 0, 133, 134, 3, 4,
-		2, 0, 134, 19, 1, 0, 0, 0, 135, 140, 3, 22, 11, 0, 136, 137, 5, 50, 0,
-		0, 137, 139, 3, 22, 11, 0, 138, 136, 1, 0, 0, 0, 139, 142, 1, 0, 0, 0,
-		140, 138, 1, 0, 0, 0, 140, 141, 1, 0, 0, 0, 141, 144, 1, 0, 0, 0, 142,
-		140, 1, 0, 0, 0, 143, 145, 5, 50, 0, 0, 144, 143, 1, 0, 0, 0, 144, 145,
-		1, 0, 0, 0, 145, 21, 1, 0, 0, 0, 146, 149, 5, 54, 0, 0, 147, 148, 5, 37,
-		0, 0, 148, 150, 3, 36, 18, 0, 149, 147, 1, 0, 0, 0, 149, 150, 1, 0, 0,
-		0, 150, 153, 1, 0, 0, 0, 151, 152, 5, 52, 0, 0, 152, 154, 3, 24, 12, 0,
-		153, 151, 1, 0, 0, 0, 153, 154, 1, 0, 0, 0, 154, 158, 1, 0, 0, 0, 155,
-		156, 5, 53, 0, 0, 156, 158, 5, 54, 0, 0, 157, 146, 1, 0, 0, 0, 157, 155,
-		1, 0, 0, 0, 158, 23, 1, 0, 0, 0, 159, 160, 5, 54, 0, 0, 160, 25, 1, 0,
-		0, 0, 161, 162, 5, 7, 0, 0, 162, 27, 1, 0, 0, 0, 163, 164, 5, 8, 0, 0,
-		164, 29, 1, 0, 0, 0, 165, 167, 5, 9, 0, 0, 166, 168, 3, 36, 18, 0, 167,
-		166, 1, 0, 0, 0, 167, 168, 1, 0, 0, 0, 168, 31, 1, 0, 0, 0, 169, 170, 5,
-		10, 0, 0, 170, 171, 5, 56, 0, 0, 171, 33, 1, 0, 0, 0, 172, 173, 5, 11,
-		0, 0, 173, 182, 5, 44, 0, 0, 174, 179, 3, 36, 18, 0, 175, 176, 5, 50, 0,
-		0, 176, 178, 3, 36, 18, 0, 177, 175, 1, 0, 0, 0, 178, 181, 1, 0, 0, 0,
-		179, 177, 1, 0, 0, 0, 179, 180, 1, 0, 0, 0, 180, 183, 1, 0, 0, 0, 181,
-		179, 1, 0, 0, 0, 182, 174, 1, 0, 0, 0, 182, 183, 1, 0, 0, 0, 183, 184,
-		1, 0, 0, 0, 184, 185, 5, 45, 0, 0, 185, 35, 1, 0, 0, 0, 186, 187, 6, 18,
-		-1, 0, 187, 188, 3, 38, 19, 0, 188, 251, 1, 0, 0, 0, 189, 190, 10, 20,
-		0, 0, 190, 191, 5, 18, 0, 0, 191, 250, 3, 36, 18, 21, 192, 193, 10, 19,
-		0, 0, 193, 194, 5, 21, 0, 0, 194, 250, 3, 36, 18, 20, 195, 196, 10, 18,
-		0, 0, 196, 197, 5, 22, 0, 0, 197, 250, 3, 36, 18, 19, 198, 199, 10, 17,
-		0, 0, 199, 200, 5, 23, 0, 0, 200, 250, 3, 36, 18, 18, 201, 202, 10, 16,
-		0, 0, 202, 203, 5, 24, 0, 0, 203, 250, 3, 36, 18, 17, 204, 205, 10, 15,
-		0, 0, 205, 206, 5, 19, 0, 0, 206, 250, 3, 36, 18, 16, 207, 208, 10, 14,
-		0, 0, 208, 209, 5, 20, 0, 0, 209, 250, 3, 36, 18, 15, 210, 211, 10, 13,
-		0, 0, 211, 212, 5, 25, 0, 0, 212, 250, 3, 36, 18, 14, 213, 214, 10, 12,
-		0, 0, 214, 215, 5, 26, 0, 0, 215, 250, 3, 36, 18, 13, 216, 217, 10, 11,
-		0, 0, 217, 218, 5, 27, 0, 0, 218, 250, 3, 36, 18, 12, 219, 220, 10, 10,
-		0, 0, 220, 221, 5, 29, 0, 0, 221, 250, 3, 36, 18, 11, 222, 223, 10, 9,
-		0, 0, 223, 224, 5, 30, 0, 0, 224, 250, 3, 36, 18, 10, 225, 226, 10, 8,
-		0, 0, 226, 227, 5, 33, 0, 0, 227, 250, 3, 36, 18, 9, 228, 229, 10, 7, 0,
-		0, 229, 230, 5, 34, 0, 0, 230, 250, 3, 36, 18, 8, 231, 232, 10, 6, 0, 0,
-		232, 233, 5, 35, 0, 0, 233, 250, 3, 36, 18, 7, 234, 235, 10, 5, 0, 0, 235,
-		236, 5, 36, 0, 0, 236, 250, 3, 36, 18, 6, 237, 238, 10, 4, 0, 0, 238, 239,
-		5, 31, 0, 0, 239, 250, 3, 36, 18, 5, 240, 241, 10, 3, 0, 0, 241, 242, 5,
-		32, 0, 0, 242, 250, 3, 36, 18, 4, 243, 244, 10, 2, 0, 0, 244, 245, 5, 15,
-		0, 0, 245, 250, 3, 36, 18, 3, 246, 247, 10, 1, 0, 0, 247, 248, 5, 16, 0,
-		0, 248, 250, 3, 36, 18, 2, 249, 189, 1, 0, 0, 0, 249, 192, 1, 0, 0, 0,
-		249, 195, 1, 0, 0, 0, 249, 198, 1, 0, 0, 0, 249, 201, 1, 0, 0, 0, 249,
-		204, 1, 0, 0, 0, 249, 207, 1, 0, 0, 0, 249, 210, 1, 0, 0, 0, 249, 213,
-		1, 0, 0, 0, 249, 216, 1, 0, 0, 0, 249, 219, 1, 0, 0, 0, 249, 222, 1, 0,
-		0, 0, 249, 225, 1, 0, 0, 0, 249, 228, 1, 0, 0, 0, 249, 231, 1, 0, 0, 0,
-		249, 234, 1, 0, 0, 0, 249, 237, 1, 0, 0, 0, 249, 240, 1, 0, 0, 0, 249,
-		243, 1, 0, 0, 0, 249, 246, 1, 0, 0, 0, 250, 253, 1, 0, 0, 0, 251, 249,
-		1, 0, 0, 0, 251, 252, 1, 0, 0, 0, 252, 37, 1, 0, 0, 0, 253, 251, 1, 0,
-		0, 0, 254, 255, 5, 17, 0, 0, 255, 262, 3, 38, 19, 0, 256, 257, 5, 28, 0,
-		0, 257, 262, 3, 38, 19, 0, 258, 259, 5, 20, 0, 0, 259, 262, 3, 38, 19,
-		0, 260, 262, 3, 40, 20, 0, 261, 254, 1, 0, 0, 0, 261, 256, 1, 0, 0, 0,
-		261, 258, 1, 0, 0, 0, 261, 260, 1, 0, 0, 0, 262, 39, 1, 0, 0, 0, 263, 264,
-		6, 20, -1, 0, 264, 265, 3, 44, 22, 0, 265, 282, 1, 0, 0, 0, 266, 267, 10,
-		3, 0, 0, 267, 269, 5, 44, 0, 0, 268, 270, 3, 42, 21, 0, 269, 268, 1, 0,
-		0, 0, 269, 270, 1, 0, 0, 0, 270, 271, 1, 0, 0, 0, 271, 281, 5, 45, 0, 0,
-		272, 273, 10, 2, 0, 0, 273, 274, 5, 46, 0, 0, 274, 275, 3, 36, 18, 0, 275,
-		276, 5, 47, 0, 0, 276, 281, 1, 0, 0, 0, 277, 278, 10, 1, 0, 0, 278, 279,
-		5, 51, 0, 0, 279, 281, 5, 54, 0, 0, 280, 266, 1, 0, 0, 0, 280, 272, 1,
-		0, 0, 0, 280, 277, 1, 0, 0, 0, 281, 284, 1, 0, 0, 0, 282, 280, 1, 0, 0,
-		0, 282, 283, 1, 0, 0, 0, 283, 41, 1, 0, 0, 0, 284, 282, 1, 0, 0, 0, 285,
-		290, 3, 36, 18, 0, 286, 287, 5, 50, 0, 0, 287, 289, 3, 36, 18, 0, 288,
-		286, 1, 0, 0, 0, 289, 292, 1, 0, 0, 0, 290, 288, 1, 0, 0, 0, 290, 291,
-		1, 0, 0, 0, 291, 43, 1, 0, 0, 0, 292, 290, 1, 0, 0, 0, 293, 312, 3, 46,
-		23, 0, 294, 312, 5, 54, 0, 0, 295, 296, 5, 44, 0, 0, 296, 297, 3, 36, 18,
-		0, 297, 298, 5, 45, 0, 0, 298, 312, 1, 0, 0, 0, 299, 300, 5, 44, 0, 0,
-		300, 303, 3, 36, 18, 0, 301, 302, 5, 50, 0, 0, 302, 304, 3, 36, 18, 0,
-		303, 301, 1, 0, 0, 0, 304, 305, 1, 0, 0, 0, 305, 303, 1, 0, 0, 0, 305,
-		306, 1, 0, 0, 0, 306, 307, 1, 0, 0, 0, 307, 308, 5, 45, 0, 0, 308, 312,
-		1, 0, 0, 0, 309, 312, 3, 48, 24, 0, 310, 312, 3, 50, 25, 0, 311, 293, 1,
-		0, 0, 0, 311, 294, 1, 0, 0, 0, 311, 295, 1, 0, 0, 0, 311, 299, 1, 0, 0,
-		0, 311, 309, 1, 0, 0, 0, 311, 310, 1, 0, 0, 0, 312, 45, 1, 0, 0, 0, 313,
+		75, 1, 0, 0, 0, 76, 370, 1, 0, 0, 0, 76, 371, 1, 0, 0, 0, 77, 3, 1, 0,
+		0, 0, 78, 82, 5, 48, 0, 0, 79, 81, 3, 2, 1, 0, 80, 79, 1, 0, 0, 0, 81,
+		84, 1, 0, 0, 0, 82, 80, 1, 0, 0, 0, 82, 83, 1, 0, 0, 0, 83, 85, 1, 0, 0,
+		0, 84, 82, 1, 0, 0, 0, 85, 86, 5, 49, 0, 0, 86, 5, 1, 0, 0, 0, 87, 88,
+		3, 36, 18, 0, 88, 7, 1, 0, 0, 0, 89, 90, 3, 410, 33, 0, 90, 91, 7, 0, 0,
+		0, 91, 92, 3, 414, 35, 0, 92, 9, 1, 0, 0, 0, 93, 104, 5, 54, 0, 0, 94,
+		95, 3, 40, 20, 0, 95, 96, 5, 46, 0, 0, 96, 97, 3, 36, 18, 0, 97, 98, 5,
+		47, 0, 0, 98, 104, 1, 0, 0, 0, 99, 100, 3, 40, 20, 0, 100, 101, 5, 51,
+		0, 0, 101, 102, 5, 54, 0, 0, 102, 104, 1, 0, 0, 0, 103, 93, 1, 0, 0, 0,
+		103, 94, 1, 0, 0, 0, 103, 99, 1, 0, 0, 0, 104, 11, 1, 0, 0, 0, 105, 106,
+		5, 2, 0, 0, 106, 107, 3, 36, 18, 0, 107, 110, 3, 4, 2, 0, 108, 408, 5,
+		3, 0, 0, 109, 407, 3, 4, 2, 0, 110, 108, 1, 0, 0, 0, 110, 111, 1, 0, 0,
+		0, 111, 13, 1, 0, 0, 0, 112, 113, 5, 4, 0, 0, 113, 114, 3, 36, 18, 0, 114,
+		115, 3, 4, 2, 0, 115, 15, 1, 0, 0, 0, 116, 117, 5, 5, 0, 0, 117, 118, 3,
+		410, 33, 0, 118, 119, 5, 6, 0, 0, 119, 120, 3, 36, 18, 0, 120, 121, 3,
+		4, 2, 0, 121, 17, 1, 0, 0, 0, 122, 123, 5, 1, 0, 0, 123, 124, 5, 54, 0,
+		0, 124, 126, 5, 44, 0, 0, 125, 127, 3, 20, 10, 0, 126, 125, 1, 0, 0, 0,
+		126, 127, 1, 0, 0, 0, 127, 128, 1, 0, 0, 0, 128, 131, 5, 45, 0, 0, 129,
+		130, 5, 43, 0, 0, 130, 132, 3, 24, 12, 0, 131, 129, 1, 0, 0, 0, 131, 132,
+		1, 0, 0, 0, 132, 133, 1, 0, 0, 0, 133, 134, 3, 4, 2, 0, 134, 19, 1, 0,
+		0, 0, 135, 140, 3, 22, 11, 0, 136, 137, 5, 50, 0, 0, 137, 139, 3, 22, 11,
+		0, 138, 136, 1, 0, 0, 0, 139, 142, 1, 0, 0, 0, 140, 138, 1, 0, 0, 0, 140,
+		141, 1, 0, 0, 0, 141, 144, 1, 0, 0, 0, 142, 140, 1, 0, 0, 0, 143, 145,
+		5, 50, 0, 0, 144, 143, 1, 0, 0, 0, 144, 145, 1, 0, 0, 0, 145, 21, 1, 0,
+		0, 0, 146, 149, 5, 54, 0, 0, 147, 148, 5, 37, 0, 0, 148, 150, 3, 36, 18,
+		0, 149, 147, 1, 0, 0, 0, 149, 150, 1, 0, 0, 0, 150, 153, 1, 0, 0, 0, 151,
+		152, 5, 52, 0, 0, 152, 154, 3, 24, 12, 0, 153, 151, 1, 0, 0, 0, 153, 154,
+		1, 0, 0, 0, 154, 158, 1, 0, 0, 0, 155, 156, 5, 53, 0, 0, 156, 158, 5, 54,
+		0, 0, 157, 146, 1, 0, 0, 0, 157, 155, 1, 0, 0, 0, 158, 23, 1, 0, 0, 0,
+		159, 160, 5, 54, 0, 0, 160, 25, 1, 0, 0, 0, 161, 162, 5, 7, 0, 0, 162,
+		27, 1, 0, 0, 0, 163, 164, 5, 8, 0, 0, 164, 29, 1, 0, 0, 0, 165, 167, 5,
+		9, 0, 0, 166, 168, 3, 414, 35, 0, 167, 166, 1, 0, 0, 0, 167, 168, 1, 0,
+		0, 0, 168, 31, 1, 0, 0, 0, 169, 170, 5, 10, 0, 0, 170, 171, 5, 56, 0, 0,
+		171, 33, 1, 0, 0, 0, 172, 173, 5, 11, 0, 0, 173, 182, 5, 44, 0, 0, 174,
+		179, 3, 36, 18, 0, 175, 176, 5, 50, 0, 0, 176, 178, 3, 36, 18, 0, 177,
+		175, 1, 0, 0, 0, 178, 181, 1, 0, 0, 0, 179, 177, 1, 0, 0, 0, 179, 180,
+		1, 0, 0, 0, 180, 183, 1, 0, 0, 0, 181, 179, 1, 0, 0, 0, 182, 174, 1, 0,
+		0, 0, 182, 183, 1, 0, 0, 0, 183, 184, 1, 0, 0, 0, 184, 185, 5, 45, 0, 0,
+		185, 35, 1, 0, 0, 0, 186, 187, 6, 18, -1, 0, 187, 188, 3, 38, 19, 0, 188,
+		251, 1, 0, 0, 0, 189, 190, 10, 20, 0, 0, 190, 191, 5, 18, 0, 0, 191, 250,
+		3, 36, 18, 21, 192, 193, 10, 19, 0, 0, 193, 194, 5, 21, 0, 0, 194, 250,
+		3, 36, 18, 20, 195, 196, 10, 18, 0, 0, 196, 197, 5, 22, 0, 0, 197, 250,
+		3, 36, 18, 19, 198, 199, 10, 17, 0, 0, 199, 200, 5, 23, 0, 0, 200, 250,
+		3, 36, 18, 18, 201, 202, 10, 16, 0, 0, 202, 203, 5, 24, 0, 0, 203, 250,
+		3, 36, 18, 17, 204, 205, 10, 15, 0, 0, 205, 206, 5, 19, 0, 0, 206, 250,
+		3, 36, 18, 16, 207, 208, 10, 14, 0, 0, 208, 209, 5, 20, 0, 0, 209, 250,
+		3, 36, 18, 15, 210, 211, 10, 13, 0, 0, 211, 212, 5, 25, 0, 0, 212, 250,
+		3, 36, 18, 14, 213, 214, 10, 12, 0, 0, 214, 215, 5, 26, 0, 0, 215, 250,
+		3, 36, 18, 13, 216, 217, 10, 11, 0, 0, 217, 218, 5, 27, 0, 0, 218, 250,
+		3, 36, 18, 12, 219, 220, 10, 10, 0, 0, 220, 221, 5, 29, 0, 0, 221, 250,
+		3, 36, 18, 11, 222, 223, 10, 9, 0, 0, 223, 224, 5, 30, 0, 0, 224, 250,
+		3, 36, 18, 10, 225, 226, 10, 8, 0, 0, 226, 227, 5, 33, 0, 0, 227, 250,
+		3, 36, 18, 9, 228, 229, 10, 7, 0, 0, 229, 230, 5, 34, 0, 0, 230, 250, 3,
+		36, 18, 8, 231, 232, 10, 6, 0, 0, 232, 233, 5, 35, 0, 0, 233, 250, 3, 36,
+		18, 7, 234, 235, 10, 5, 0, 0, 235, 236, 5, 36, 0, 0, 236, 250, 3, 36, 18,
+		6, 237, 238, 10, 4, 0, 0, 238, 239, 5, 31, 0, 0, 239, 250, 3, 36, 18, 5,
+		240, 241, 10, 3, 0, 0, 241, 242, 5, 32, 0, 0, 242, 250, 3, 36, 18, 4, 243,
+		244, 10, 2, 0, 0, 244, 245, 5, 15, 0, 0, 245, 250, 3, 36, 18, 3, 246, 247,
+		10, 1, 0, 0, 247, 248, 5, 16, 0, 0, 248, 250, 3, 36, 18, 2, 249, 189, 1,
+		0, 0, 0, 249, 192, 1, 0, 0, 0, 249, 195, 1, 0, 0, 0, 249, 198, 1, 0, 0,
+		0, 249, 201, 1, 0, 0, 0, 249, 204, 1, 0, 0, 0, 249, 207, 1, 0, 0, 0, 249,
+		210, 1, 0, 0, 0, 249, 213, 1, 0, 0, 0, 249, 216, 1, 0, 0, 0, 249, 219,
+		1, 0, 0, 0, 249, 222, 1, 0, 0, 0, 249, 225, 1, 0, 0, 0, 249, 228, 1, 0,
+		0, 0, 249, 231, 1, 0, 0, 0, 249, 234, 1, 0, 0, 0, 249, 237, 1, 0, 0, 0,
+		249, 240, 1, 0, 0, 0, 249, 243, 1, 0, 0, 0, 249, 246, 1, 0, 0, 0, 250,
+		253, 1, 0, 0, 0, 251, 249, 1, 0, 0, 0, 251, 252, 1, 0, 0, 0, 252, 37, 1,
+		0, 0, 0, 253, 251, 1, 0, 0, 0, 254, 255, 5, 17, 0, 0, 255, 262, 3, 38,
+		19, 0, 256, 257, 5, 28, 0, 0, 257, 262, 3, 38, 19, 0, 258, 259, 5, 20,
+		0, 0, 259, 262, 3, 38, 19, 0, 260, 262, 3, 40, 20, 0, 261, 254, 1, 0, 0,
+		0, 261, 256, 1, 0, 0, 0, 261, 258, 1, 0, 0, 0, 261, 260, 1, 0, 0, 0, 262,
+		39, 1, 0, 0, 0, 263, 264, 6, 20, -1, 0, 264, 265, 3, 44, 22, 0, 265, 282,
+		1, 0, 0, 0, 266, 267, 10, 3, 0, 0, 267, 269, 5, 44, 0, 0, 268, 270, 3,
+		42, 21, 0, 269, 268, 1, 0, 0, 0, 269, 270, 1, 0, 0, 0, 270, 271, 1, 0,
+		0, 0, 271, 281, 5, 45, 0, 0, 272, 273, 10, 2, 0, 0, 273, 274, 5, 46, 0,
+		0, 274, 275, 3, 36, 18, 0, 275, 276, 5, 47, 0, 0, 276, 281, 1, 0, 0, 0,
+		277, 278, 10, 1, 0, 0, 278, 279, 5, 51, 0, 0, 279, 281, 5, 54, 0, 0, 280,
+		266, 1, 0, 0, 0, 280, 272, 1, 0, 0, 0, 280, 277, 1, 0, 0, 0, 281, 284,
+		1, 0, 0, 0, 282, 280, 1, 0, 0, 0, 282, 283, 1, 0, 0, 0, 283, 41, 1, 0,
+		0, 0, 284, 282, 1, 0, 0, 0, 285, 290, 3, 399, 32, 0, 286, 287, 5, 50, 0,
+		0, 287, 289, 3, 399, 32, 0, 288, 286, 1, 0, 0, 0, 289, 292, 1, 0, 0, 0,
+		290, 288, 1, 0, 0, 0, 290, 291, 1, 0, 0, 0, 291, 43, 1, 0, 0, 0, 292, 290,
+		1, 0, 0, 0, 293, 312, 3, 46, 23, 0, 294, 312, 5, 54, 0, 0, 295, 296, 5,
+		44, 0, 0, 296, 297, 3, 36, 18, 0, 297, 298, 5, 45, 0, 0, 298, 312, 1, 0,
+		0, 0, 299, 300, 5, 44, 0, 0, 300, 303, 3, 36, 18, 0, 301, 302, 5, 50, 0,
+		0, 302, 304, 3, 36, 18, 0, 303, 301, 1, 0, 0, 0, 304, 305, 1, 0, 0, 0,
+		305, 303, 1, 0, 0, 0, 305, 306, 1, 0, 0, 0, 306, 307, 1, 0, 0, 0, 307,
+		308, 5, 45, 0, 0, 308, 312, 1, 0, 0, 0, 309, 312, 3, 48, 24, 0, 310, 312,
+		3, 50, 25, 0, 311, 293, 1, 0, 0, 0, 311, 294, 1, 0, 0, 0, 311, 295, 1,
+		0, 0, 0, 311, 299, 1, 0, 0, 0, 311, 309, 1, 0, 0, 0, 311, 310, 1, 0, 0,
+		0, 311, 397, 1, 0, 0, 0, 311, 398, 1, 0, 0, 0, 312, 45, 1, 0, 0, 0, 313,
 		314, 7, 1, 0, 0, 314, 47, 1, 0, 0, 0, 315, 324, 5, 46, 0, 0, 316, 321,
 		3, 36, 18, 0, 317, 318, 5, 50, 0, 0, 318, 320, 3, 36, 18, 0, 319, 317,
 		1, 0, 0, 0, 320, 323, 1, 0, 0, 0, 321, 319, 1, 0, 0, 0, 321, 322, 1, 0,
@@ -217,9 +231,39 @@ func inscriptParserInit() {
 		3, 54, 27, 0, 342, 343, 5, 37, 0, 0, 343, 344, 3, 36, 18, 0, 344, 53, 1,
 		0, 0, 0, 345, 349, 3, 36, 18, 0, 346, 349, 5, 56, 0, 0, 347, 349, 5, 54,
 		0, 0, 348, 345, 1, 0, 0, 0, 348, 346, 1, 0, 0, 0, 348, 347, 1, 0, 0, 0,
-		349, 55, 1, 0, 0, 0, 29, 59, 76, 82, 103, 110, 126, 131, 140, 144, 149,
-		153, 157, 167, 179, 182, 249, 251, 261, 269, 280, 282, 290, 305, 311, 321,
-		324, 334, 337, 348,
+		349, 55, 1, 0, 0, 0, 351, 355, 1, 0, 0, 0, 353, 368, 1, 0, 0, 0, 355, 356,
+		5, 60, 0, 0, 356, 367, 3, 4, 2, 0, 357, 352, 1, 0, 0, 0, 358, 359, 5, 62,
+		0, 0, 359, 360, 5, 54, 0, 0, 360, 364, 3, 4, 2, 0, 361, 357, 1, 0, 0, 0,
+		362, 363, 5, 63, 0, 0, 363, 361, 3, 4, 2, 0, 364, 362, 1, 0, 0, 0, 364,
+		361, 1, 0, 0, 0, 365, 366, 5, 63, 0, 0, 366, 357, 3, 4, 2, 0, 367, 358,
+		1, 0, 0, 0, 367, 365, 1, 0, 0, 0, 368, 369, 5, 61, 0, 0, 369, 354, 3, 36,
+		18, 0, 370, 77, 3, 351, 28, 0, 371, 77, 3, 353, 29, 0, 372, 376, 1, 0,
+		0, 0, 374, 396, 1, 0, 0, 0, 376, 377, 5, 1, 0, 0, 377, 386, 5, 44, 0, 0,
+		378, 383, 5, 45, 0, 0, 379, 373, 3, 4, 2, 0, 380, 379, 1, 0, 0, 0, 381,
+		382, 5, 43, 0, 0, 382, 380, 3, 24, 12, 0, 383, 381, 1, 0, 0, 0, 383, 380,
+		1, 0, 0, 0, 384, 378, 1, 0, 0, 0, 385, 384, 3, 20, 10, 0, 386, 385, 1,
+		0, 0, 0, 386, 384, 1, 0, 0, 0, 387, 388, 5, 43, 0, 0, 388, 375, 3, 36,
+		18, 0, 389, 387, 1, 0, 0, 0, 390, 389, 5, 54, 0, 0, 391, 395, 5, 44, 0,
+		0, 392, 389, 5, 45, 0, 0, 393, 392, 1, 0, 0, 0, 394, 393, 3, 20, 10, 0,
+		395, 394, 1, 0, 0, 0, 395, 393, 1, 0, 0, 0, 396, 390, 1, 0, 0, 0, 396,
+		391, 1, 0, 0, 0, 397, 312, 3, 372, 30, 0, 398, 312, 3, 374, 31, 0, 399,
+		406, 1, 0, 0, 0, 401, 400, 1, 0, 0, 0, 402, 403, 5, 54, 0, 0, 403, 404,
+		5, 37, 0, 0, 404, 401, 3, 36, 18, 0, 405, 401, 3, 36, 18, 0, 406, 402,
+		1, 0, 0, 0, 406, 405, 1, 0, 0, 0, 407, 111, 1, 0, 0, 0, 408, 409, 1, 0,
+		0, 0, 408, 109, 1, 0, 0, 0, 409, 407, 3, 12, 6, 0, 410, 416, 1, 0, 0, 0,
+		412, 431, 1, 0, 0, 0, 414, 432, 1, 0, 0, 0, 416, 421, 3, 412, 34, 0, 417,
+		423, 1, 0, 0, 0, 418, 419, 5, 50, 0, 0, 419, 417, 3, 412, 34, 0, 420, 418,
+		1, 0, 0, 0, 421, 420, 1, 0, 0, 0, 421, 422, 1, 0, 0, 0, 422, 411, 1, 0,
+		0, 0, 423, 421, 1, 0, 0, 0, 424, 413, 1, 0, 0, 0, 425, 424, 3, 10, 5, 0,
+		426, 427, 5, 44, 0, 0, 427, 428, 3, 410, 33, 0, 428, 424, 5, 45, 0, 0,
+		429, 430, 5, 53, 0, 0, 430, 424, 5, 54, 0, 0, 431, 425, 1, 0, 0, 0, 431,
+		426, 1, 0, 0, 0, 431, 429, 1, 0, 0, 0, 432, 437, 3, 36, 18, 0, 433, 439,
+		1, 0, 0, 0, 434, 435, 5, 50, 0, 0, 435, 433, 3, 36, 18, 0, 436, 434, 1,
+		0, 0, 0, 437, 436, 1, 0, 0, 0, 437, 438, 1, 0, 0, 0, 438, 415, 1, 0, 0,
+		0, 439, 437, 1, 0, 0, 0, 40, 59, 76, 82, 103, 110, 126, 131, 140, 144,
+		149, 153, 157, 167, 179, 182, 249, 251, 261, 269, 280, 282, 290, 305, 311,
+		321, 324, 334, 337, 348, 364, 367, 383, 386, 395, 396, 406, 408, 421, 431,
+		437,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -317,38 +361,50 @@ const (
 	InscriptParserCOMMENT       = 57
 	InscriptParserBLOCK_COMMENT = 58
 	InscriptParserWS            = 59
+	InscriptParserTRY           = 60
+	InscriptParserTHROW         = 61
+	InscriptParserCATCH         = 62
+	InscriptParserFINALLY       = 63
 )
 
 // InscriptParser rules.
 const (
-	InscriptParserRULE_program        = 0
-	InscriptParserRULE_statement      = 1
-	InscriptParserRULE_block          = 2
-	InscriptParserRULE_exprStmt       = 3
-	InscriptParserRULE_assignment     = 4
-	InscriptParserRULE_target         = 5
-	InscriptParserRULE_ifStmt         = 6
-	InscriptParserRULE_whileStmt      = 7
-	InscriptParserRULE_forStmt        = 8
-	InscriptParserRULE_funcDef        = 9
-	InscriptParserRULE_paramList      = 10
-	InscriptParserRULE_param          = 11
-	InscriptParserRULE_typeAnnotation = 12
-	InscriptParserRULE_breakStmt      = 13
-	InscriptParserRULE_continueStmt   = 14
-	InscriptParserRULE_returnStmt     = 15
-	InscriptParserRULE_importStmt     = 16
-	InscriptParserRULE_printStmt      = 17
-	InscriptParserRULE_expression     = 18
-	InscriptParserRULE_unaryExpr      = 19
-	InscriptParserRULE_postfixExpr    = 20
-	InscriptParserRULE_argList        = 21
-	InscriptParserRULE_primary        = 22
-	InscriptParserRULE_literal        = 23
-	InscriptParserRULE_listLiteral    = 24
-	InscriptParserRULE_tableLiteral   = 25
-	InscriptParserRULE_tableKeyValue  = 26
-	InscriptParserRULE_tableKey       = 27
+	InscriptParserRULE_program         = 0
+	InscriptParserRULE_statement       = 1
+	InscriptParserRULE_block           = 2
+	InscriptParserRULE_exprStmt        = 3
+	InscriptParserRULE_assignment      = 4
+	InscriptParserRULE_target          = 5
+	InscriptParserRULE_ifStmt          = 6
+	InscriptParserRULE_whileStmt       = 7
+	InscriptParserRULE_forStmt         = 8
+	InscriptParserRULE_funcDef         = 9
+	InscriptParserRULE_paramList       = 10
+	InscriptParserRULE_param           = 11
+	InscriptParserRULE_typeAnnotation  = 12
+	InscriptParserRULE_breakStmt       = 13
+	InscriptParserRULE_continueStmt    = 14
+	InscriptParserRULE_returnStmt      = 15
+	InscriptParserRULE_importStmt      = 16
+	InscriptParserRULE_printStmt       = 17
+	InscriptParserRULE_expression      = 18
+	InscriptParserRULE_unaryExpr       = 19
+	InscriptParserRULE_postfixExpr     = 20
+	InscriptParserRULE_argList         = 21
+	InscriptParserRULE_primary         = 22
+	InscriptParserRULE_literal         = 23
+	InscriptParserRULE_listLiteral     = 24
+	InscriptParserRULE_tableLiteral    = 25
+	InscriptParserRULE_tableKeyValue   = 26
+	InscriptParserRULE_tableKey        = 27
+	InscriptParserRULE_tryStmt         = 28
+	InscriptParserRULE_throwStmt       = 29
+	InscriptParserRULE_functionLiteral = 30
+	InscriptParserRULE_arrowFunction   = 31
+	InscriptParserRULE_argument        = 32
+	InscriptParserRULE_targetList      = 33
+	InscriptParserRULE_targetItem      = 34
+	InscriptParserRULE_expressionList  = 35
 )
 
 // IProgramContext is an interface to support dynamic dispatch.
@@ -487,7 +543,7 @@ func (p *InscriptParser) Program() (localctx IProgramContext) {
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&126470225742954422) != 0 {
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3594241938818236342) != 0 {
 		{
 			p.SetState(56)
 			p.Statement()
@@ -542,6 +598,8 @@ type IStatementContext interface {
 	ImportStmt() IImportStmtContext
 	PrintStmt() IPrintStmtContext
 	Block() IBlockContext
+	TryStmt() ITryStmtContext
+	ThrowStmt() IThrowStmtContext
 
 	// IsStatementContext differentiates from other interfaces.
 	IsStatementContext()
@@ -771,6 +829,38 @@ func (s *StatementContext) Block() IBlockContext {
 	return t.(IBlockContext)
 }
 
+func (s *StatementContext) TryStmt() ITryStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITryStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITryStmtContext)
+}
+
+func (s *StatementContext) ThrowStmt() IThrowStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IThrowStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IThrowStmtContext)
+}
+
 func (s *StatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -895,6 +985,20 @@ func (p *InscriptParser) Statement() (localctx IStatementContext) {
 			p.Block()
 		}
 
+	case 13:
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(370)
+			p.TryStmt()
+		}
+
+	case 14:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(371)
+			p.ThrowStmt()
+		}
+
 	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
@@ -1061,7 +1165,7 @@ func (p *InscriptParser) Block() (localctx IBlockContext) {
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&126470225742954422) != 0 {
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3594241938818236342) != 0 {
 		{
 			p.SetState(79)
 			p.Statement()
@@ -1218,8 +1322,8 @@ type IAssignmentContext interface {
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Target() ITargetContext
-	Expression() IExpressionContext
+	TargetList() ITargetListContext
+	ExpressionList() IExpressionListContext
 	ASSIGN() antlr.TerminalNode
 	ADD_ASSIGN() antlr.TerminalNode
 	SUB_ASSIGN() antlr.TerminalNode
@@ -1263,10 +1367,10 @@ func NewAssignmentContext(parser antlr.Parser, parent antlr.ParserRuleContext, i
 
 func (s *AssignmentContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *AssignmentContext) Target() ITargetContext {
+func (s *AssignmentContext) TargetList() ITargetListContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITargetContext); ok {
+		if _, ok := ctx.(ITargetListContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1276,13 +1380,13 @@ func (s *AssignmentContext) Target() ITargetContext {
 		return nil
 	}
 
-	return t.(ITargetContext)
+	return t.(ITargetListContext)
 }
 
-func (s *AssignmentContext) Expression() IExpressionContext {
+func (s *AssignmentContext) ExpressionList() IExpressionListContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IExpressionListContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1292,7 +1396,7 @@ func (s *AssignmentContext) Expression() IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IExpressionListContext)
 }
 
 func (s *AssignmentContext) ASSIGN() antlr.TerminalNode {
@@ -1357,7 +1461,7 @@ func (p *InscriptParser) Assignment() (localctx IAssignmentContext) {
 	p.EnterOuterAlt(localctx, 1)
 	{
 		p.SetState(89)
-		p.Target()
+		p.TargetList()
 	}
 	{
 		p.SetState(90)
@@ -1372,7 +1476,7 @@ func (p *InscriptParser) Assignment() (localctx IAssignmentContext) {
 	}
 	{
 		p.SetState(91)
-		p.expression(0)
+		p.ExpressionList()
 	}
 
 errorExit:
@@ -1618,6 +1722,7 @@ type IIfStmtContext interface {
 	AllBlock() []IBlockContext
 	Block(i int) IBlockContext
 	ELSE() antlr.TerminalNode
+	IfStmt() IIfStmtContext
 
 	// IsIfStmtContext differentiates from other interfaces.
 	IsIfStmtContext()
@@ -1720,6 +1825,22 @@ func (s *IfStmtContext) ELSE() antlr.TerminalNode {
 	return s.GetToken(InscriptParserELSE, 0)
 }
 
+func (s *IfStmtContext) IfStmt() IIfStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIfStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIfStmtContext)
+}
+
 func (s *IfStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1788,9 +1909,28 @@ func (p *InscriptParser) IfStmt() (localctx IIfStmtContext) {
 				goto errorExit
 			}
 		}
-		{
-			p.SetState(109)
-			p.Block()
+		p.SetState(408)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetTokenStream().LA(1) {
+		case InscriptParserIF:
+			{
+				p.SetState(409)
+				p.IfStmt()
+			}
+
+		case InscriptParserLBRACE:
+			{
+				p.SetState(109)
+				p.Block()
+			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
 		}
 
 	}
@@ -1965,7 +2105,7 @@ type IForStmtContext interface {
 
 	// Getter signatures
 	FOR() antlr.TerminalNode
-	IDENTIFIER() antlr.TerminalNode
+	TargetList() ITargetListContext
 	IN() antlr.TerminalNode
 	Expression() IExpressionContext
 	Block() IBlockContext
@@ -2010,8 +2150,20 @@ func (s *ForStmtContext) FOR() antlr.TerminalNode {
 	return s.GetToken(InscriptParserFOR, 0)
 }
 
-func (s *ForStmtContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(InscriptParserIDENTIFIER, 0)
+func (s *ForStmtContext) TargetList() ITargetListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITargetListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITargetListContext)
 }
 
 func (s *ForStmtContext) IN() antlr.TerminalNode {
@@ -2094,11 +2246,7 @@ func (p *InscriptParser) ForStmt() (localctx IForStmtContext) {
 	}
 	{
 		p.SetState(117)
-		p.Match(InscriptParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+		p.TargetList()
 	}
 	{
 		p.SetState(118)
@@ -3140,7 +3288,7 @@ type IReturnStmtContext interface {
 
 	// Getter signatures
 	RETURN() antlr.TerminalNode
-	Expression() IExpressionContext
+	ExpressionList() IExpressionListContext
 
 	// IsReturnStmtContext differentiates from other interfaces.
 	IsReturnStmtContext()
@@ -3182,10 +3330,10 @@ func (s *ReturnStmtContext) RETURN() antlr.TerminalNode {
 	return s.GetToken(InscriptParserRETURN, 0)
 }
 
-func (s *ReturnStmtContext) Expression() IExpressionContext {
+func (s *ReturnStmtContext) ExpressionList() IExpressionListContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IExpressionListContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -3195,7 +3343,7 @@ func (s *ReturnStmtContext) Expression() IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IExpressionListContext)
 }
 
 func (s *ReturnStmtContext) GetRuleContext() antlr.RuleContext {
@@ -3246,7 +3394,7 @@ func (p *InscriptParser) ReturnStmt() (localctx IReturnStmtContext) {
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 12, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(166)
-			p.expression(0)
+			p.ExpressionList()
 		}
 
 	} else if p.HasError() { // JIM
@@ -3557,7 +3705,7 @@ func (p *InscriptParser) PrintStmt() (localctx IPrintStmtContext) {
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&126470225742950400) != 0 {
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&126470225742950402) != 0 {
 		{
 			p.SetState(174)
 			p.expression(0)
@@ -3614,92 +3762,75 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IExpressionContext is an interface to support dynamic dispatch.
-type IExpressionContext interface {
+// ITryStmtContext is an interface to support dynamic dispatch.
+type ITryStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-	// IsExpressionContext differentiates from other interfaces.
-	IsExpressionContext()
+
+	// Getter signatures
+	TRY() antlr.TerminalNode
+	AllBlock() []IBlockContext
+	Block(i int) IBlockContext
+	CATCH() antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
+	FINALLY() antlr.TerminalNode
+
+	// IsTryStmtContext differentiates from other interfaces.
+	IsTryStmtContext()
 }
 
-type ExpressionContext struct {
+type TryStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExpressionContext() *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewEmptyTryStmtContext() *TryStmtContext {
+	var p = new(TryStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = InscriptParserRULE_expression
+	p.RuleIndex = InscriptParserRULE_tryStmt
 	return p
 }
 
-func InitEmptyExpressionContext(p *ExpressionContext) {
+func InitEmptyTryStmtContext(p *TryStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = InscriptParserRULE_expression
+	p.RuleIndex = InscriptParserRULE_tryStmt
 }
 
-func (*ExpressionContext) IsExpressionContext() {}
+func (*TryStmtContext) IsTryStmtContext() {}
 
-func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewTryStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TryStmtContext {
+	var p = new(TryStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = InscriptParserRULE_expression
+	p.RuleIndex = InscriptParserRULE_tryStmt
 
 	return p
 }
 
-func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ExpressionContext) CopyAll(ctx *ExpressionContext) {
-	s.CopyFrom(&ctx.BaseParserRuleContext)
-}
-
-func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-type GeExprContext struct {
-	ExpressionContext
-}
-
-func NewGeExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *GeExprContext {
-	var p = new(GeExprContext)
-
-	InitEmptyExpressionContext(&p.ExpressionContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*ExpressionContext))
-
-	return p
-}
+func (s *TryStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *GeExprContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *TryStmtContext) TRY() antlr.TerminalNode {
+	return s.GetToken(InscriptParserTRY, 0)
 }
 
-func (s *GeExprContext) AllExpression() []IExpressionContext {
+func (s *TryStmtContext) AllBlock() []IBlockContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IBlockContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IExpressionContext, len)
+	tst := make([]IBlockContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
+		if t, ok := ctx.(IBlockContext); ok {
+			tst[i] = t.(IBlockContext)
 			i++
 		}
 	}
@@ -3707,11 +3838,11 @@ func (s *GeExprContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *GeExprContext) Expression(i int) IExpressionContext {
+func (s *TryStmtContext) Block(i int) IBlockContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IBlockContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -3724,254 +3855,209 @@ func (s *GeExprContext) Expression(i int) IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
-}
-
-func (s *GeExprContext) GE() antlr.TerminalNode {
-	return s.GetToken(InscriptParserGE, 0)
-}
-
-func (s *GeExprContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterGeExpr(s)
-	}
-}
-
-func (s *GeExprContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitGeExpr(s)
-	}
+	return t.(IBlockContext)
 }
 
-func (s *GeExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case InscriptVisitor:
-		return t.VisitGeExpr(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
+func (s *TryStmtContext) CATCH() antlr.TerminalNode {
+	return s.GetToken(InscriptParserCATCH, 0)
 }
 
-type ModExprContext struct {
-	ExpressionContext
+func (s *TryStmtContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(InscriptParserIDENTIFIER, 0)
 }
 
-func NewModExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ModExprContext {
-	var p = new(ModExprContext)
-
-	InitEmptyExpressionContext(&p.ExpressionContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*ExpressionContext))
-
-	return p
+func (s *TryStmtContext) FINALLY() antlr.TerminalNode {
+	return s.GetToken(InscriptParserFINALLY, 0)
 }
 
-func (s *ModExprContext) GetRuleContext() antlr.RuleContext {
+func (s *TryStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ModExprContext) AllExpression() []IExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
-			i++
-		}
-	}
-
-	return tst
-}
-
-func (s *ModExprContext) Expression(i int) IExpressionContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExpressionContext)
-}
-
-func (s *ModExprContext) MOD() antlr.TerminalNode {
-	return s.GetToken(InscriptParserMOD, 0)
+func (s *TryStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ModExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TryStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterModExpr(s)
+		listenerT.EnterTryStmt(s)
 	}
 }
 
-func (s *ModExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TryStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitModExpr(s)
+		listenerT.ExitTryStmt(s)
 	}
 }
 
-func (s *ModExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TryStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitModExpr(s)
+		return t.VisitTryStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type GtExprContext struct {
-	ExpressionContext
-}
-
-func NewGtExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *GtExprContext {
-	var p = new(GtExprContext)
-
-	InitEmptyExpressionContext(&p.ExpressionContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*ExpressionContext))
-
-	return p
-}
+func (p *InscriptParser) TryStmt() (localctx ITryStmtContext) {
+	localctx = NewTryStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 351, InscriptParserRULE_tryStmt)
+	var _la int
 
-func (s *GtExprContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *GtExprContext) AllExpression() []IExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
-			len++
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(355)
+		p.Match(InscriptParserTRY)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
+	{
+		p.SetState(356)
+		p.Block()
+	}
+	p.SetState(367)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
 
-	tst := make([]IExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
-			i++
+	switch p.GetTokenStream().LA(1) {
+	case InscriptParserCATCH:
+		{
+			p.SetState(358)
+			p.Match(InscriptParserCATCH)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-	}
+		{
+			p.SetState(359)
+			p.Match(InscriptParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(360)
+			p.Block()
+		}
+		p.SetState(364)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 
-	return tst
-}
+		if _la == InscriptParserFINALLY {
+			{
+				p.SetState(362)
+				p.Match(InscriptParserFINALLY)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(363)
+				p.Block()
+			}
 
-func (s *GtExprContext) Expression(i int) IExpressionContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
+		}
+
+	case InscriptParserFINALLY:
+		{
+			p.SetState(365)
+			p.Match(InscriptParserFINALLY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
 			}
-			j++
 		}
-	}
+		{
+			p.SetState(366)
+			p.Block()
+		}
 
-	if t == nil {
-		return nil
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
-	return t.(IExpressionContext)
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-func (s *GtExprContext) GT() antlr.TerminalNode {
-	return s.GetToken(InscriptParserGT, 0)
-}
+// IThrowStmtContext is an interface to support dynamic dispatch.
+type IThrowStmtContext interface {
+	antlr.ParserRuleContext
 
-func (s *GtExprContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterGtExpr(s)
-	}
-}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-func (s *GtExprContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitGtExpr(s)
-	}
+	// Getter signatures
+	THROW() antlr.TerminalNode
+	Expression() IExpressionContext
+
+	// IsThrowStmtContext differentiates from other interfaces.
+	IsThrowStmtContext()
 }
 
-func (s *GtExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case InscriptVisitor:
-		return t.VisitGtExpr(s)
+type ThrowStmtContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
-	default:
-		return t.VisitChildren(s)
-	}
+func NewEmptyThrowStmtContext() *ThrowStmtContext {
+	var p = new(ThrowStmtContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = InscriptParserRULE_throwStmt
+	return p
 }
 
-type OrExprContext struct {
-	ExpressionContext
+func InitEmptyThrowStmtContext(p *ThrowStmtContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = InscriptParserRULE_throwStmt
 }
 
-func NewOrExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *OrExprContext {
-	var p = new(OrExprContext)
+func (*ThrowStmtContext) IsThrowStmtContext() {}
+
+func NewThrowStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ThrowStmtContext {
+	var p = new(ThrowStmtContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
-	InitEmptyExpressionContext(&p.ExpressionContext)
 	p.parser = parser
-	p.CopyAll(ctx.(*ExpressionContext))
+	p.RuleIndex = InscriptParserRULE_throwStmt
 
 	return p
 }
 
-func (s *OrExprContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *OrExprContext) AllExpression() []IExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
-			i++
-		}
-	}
+func (s *ThrowStmtContext) GetParser() antlr.Parser { return s.parser }
 
-	return tst
+func (s *ThrowStmtContext) THROW() antlr.TerminalNode {
+	return s.GetToken(InscriptParserTHROW, 0)
 }
 
-func (s *OrExprContext) Expression(i int) IExpressionContext {
+func (s *ThrowStmtContext) Expression() IExpressionContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -3982,166 +4068,172 @@ func (s *OrExprContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *OrExprContext) OR() antlr.TerminalNode {
-	return s.GetToken(InscriptParserOR, 0)
+func (s *ThrowStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *OrExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ThrowStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ThrowStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterOrExpr(s)
+		listenerT.EnterThrowStmt(s)
 	}
 }
 
-func (s *OrExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ThrowStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitOrExpr(s)
+		listenerT.ExitThrowStmt(s)
 	}
 }
 
-func (s *OrExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ThrowStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitOrExpr(s)
+		return t.VisitThrowStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type IdivExprContext struct {
-	ExpressionContext
+func (p *InscriptParser) ThrowStmt() (localctx IThrowStmtContext) {
+	localctx = NewThrowStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 353, InscriptParserRULE_throwStmt)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(368)
+		p.Match(InscriptParserTHROW)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(369)
+		p.expression(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-func NewIdivExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IdivExprContext {
-	var p = new(IdivExprContext)
+// IFunctionLiteralContext is an interface to support dynamic dispatch.
+type IFunctionLiteralContext interface {
+	antlr.ParserRuleContext
 
-	InitEmptyExpressionContext(&p.ExpressionContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*ExpressionContext))
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	FUNCTION() antlr.TerminalNode
+	LPAREN() antlr.TerminalNode
+	RPAREN() antlr.TerminalNode
+	Block() IBlockContext
+	ParamList() IParamListContext
+	ARROW() antlr.TerminalNode
+	TypeAnnotation() ITypeAnnotationContext
+
+	// IsFunctionLiteralContext differentiates from other interfaces.
+	IsFunctionLiteralContext()
+}
+
+type FunctionLiteralContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
+func NewEmptyFunctionLiteralContext() *FunctionLiteralContext {
+	var p = new(FunctionLiteralContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = InscriptParserRULE_functionLiteral
 	return p
 }
 
-func (s *IdivExprContext) GetRuleContext() antlr.RuleContext {
-	return s
+func InitEmptyFunctionLiteralContext(p *FunctionLiteralContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = InscriptParserRULE_functionLiteral
 }
 
-func (s *IdivExprContext) AllExpression() []IExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
-			len++
-		}
-	}
+func (*FunctionLiteralContext) IsFunctionLiteralContext() {}
 
-	tst := make([]IExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
-			i++
-		}
-	}
+func NewFunctionLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionLiteralContext {
+	var p = new(FunctionLiteralContext)
 
-	return tst
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = InscriptParserRULE_functionLiteral
+
+	return p
 }
 
-func (s *IdivExprContext) Expression(i int) IExpressionContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
+func (s *FunctionLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExpressionContext)
+func (s *FunctionLiteralContext) FUNCTION() antlr.TerminalNode {
+	return s.GetToken(InscriptParserFUNCTION, 0)
 }
 
-func (s *IdivExprContext) IDIV() antlr.TerminalNode {
-	return s.GetToken(InscriptParserIDIV, 0)
+func (s *FunctionLiteralContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(InscriptParserLPAREN, 0)
 }
 
-func (s *IdivExprContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterIdivExpr(s)
-	}
+func (s *FunctionLiteralContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(InscriptParserRPAREN, 0)
 }
 
-func (s *IdivExprContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitIdivExpr(s)
+func (s *FunctionLiteralContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
 	}
-}
 
-func (s *IdivExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case InscriptVisitor:
-		return t.VisitIdivExpr(s)
-
-	default:
-		return t.VisitChildren(s)
+	if t == nil {
+		return nil
 	}
-}
-
-type SubExprContext struct {
-	ExpressionContext
-}
-
-func NewSubExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SubExprContext {
-	var p = new(SubExprContext)
-
-	InitEmptyExpressionContext(&p.ExpressionContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*ExpressionContext))
-
-	return p
-}
 
-func (s *SubExprContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return t.(IBlockContext)
 }
 
-func (s *SubExprContext) AllExpression() []IExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
-			len++
+func (s *FunctionLiteralContext) ParamList() IParamListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IParamListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]IExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
-			i++
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(IParamListContext)
 }
 
-func (s *SubExprContext) Expression(i int) IExpressionContext {
+func (s *FunctionLiteralContext) ARROW() antlr.TerminalNode {
+	return s.GetToken(InscriptParserARROW, 0)
+}
+
+func (s *FunctionLiteralContext) TypeAnnotation() ITypeAnnotationContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(ITypeAnnotationContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -4149,84 +4241,184 @@ func (s *SubExprContext) Expression(i int) IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(ITypeAnnotationContext)
 }
 
-func (s *SubExprContext) SUB() antlr.TerminalNode {
-	return s.GetToken(InscriptParserSUB, 0)
+func (s *FunctionLiteralContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *SubExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FunctionLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FunctionLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterSubExpr(s)
+		listenerT.EnterFunctionLiteral(s)
 	}
 }
 
-func (s *SubExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FunctionLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitSubExpr(s)
+		listenerT.ExitFunctionLiteral(s)
 	}
 }
 
-func (s *SubExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FunctionLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitSubExpr(s)
+		return t.VisitFunctionLiteral(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type NeqExprContext struct {
-	ExpressionContext
+func (p *InscriptParser) FunctionLiteral() (localctx IFunctionLiteralContext) {
+	localctx = NewFunctionLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 372, InscriptParserRULE_functionLiteral)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(376)
+		p.Match(InscriptParserFUNCTION)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(377)
+		p.Match(InscriptParserLPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(386)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == InscriptParserELLIPSIS || _la == InscriptParserIDENTIFIER {
+		{
+			p.SetState(385)
+			p.ParamList()
+		}
+
+	}
+	{
+		p.SetState(378)
+		p.Match(InscriptParserRPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(383)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == InscriptParserARROW {
+		{
+			p.SetState(381)
+			p.Match(InscriptParserARROW)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(382)
+			p.TypeAnnotation()
+		}
+
+	}
+	{
+		p.SetState(379)
+		p.Block()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-func NewNeqExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NeqExprContext {
-	var p = new(NeqExprContext)
+// IArrowFunctionContext is an interface to support dynamic dispatch.
+type IArrowFunctionContext interface {
+	antlr.ParserRuleContext
 
-	InitEmptyExpressionContext(&p.ExpressionContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*ExpressionContext))
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ARROW() antlr.TerminalNode
+	Expression() IExpressionContext
+	IDENTIFIER() antlr.TerminalNode
+	LPAREN() antlr.TerminalNode
+	RPAREN() antlr.TerminalNode
+	ParamList() IParamListContext
+
+	// IsArrowFunctionContext differentiates from other interfaces.
+	IsArrowFunctionContext()
+}
+
+type ArrowFunctionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
+func NewEmptyArrowFunctionContext() *ArrowFunctionContext {
+	var p = new(ArrowFunctionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = InscriptParserRULE_arrowFunction
 	return p
 }
 
-func (s *NeqExprContext) GetRuleContext() antlr.RuleContext {
-	return s
+func InitEmptyArrowFunctionContext(p *ArrowFunctionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = InscriptParserRULE_arrowFunction
 }
 
-func (s *NeqExprContext) AllExpression() []IExpressionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IExpressionContext); ok {
-			len++
-		}
-	}
+func (*ArrowFunctionContext) IsArrowFunctionContext() {}
 
-	tst := make([]IExpressionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
-			i++
-		}
-	}
+func NewArrowFunctionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrowFunctionContext {
+	var p = new(ArrowFunctionContext)
 
-	return tst
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = InscriptParserRULE_arrowFunction
+
+	return p
 }
 
-func (s *NeqExprContext) Expression(i int) IExpressionContext {
+func (s *ArrowFunctionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ArrowFunctionContext) ARROW() antlr.TerminalNode {
+	return s.GetToken(InscriptParserARROW, 0)
+}
+
+func (s *ArrowFunctionContext) Expression() IExpressionContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IExpressionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -4237,38 +4429,209 @@ func (s *NeqExprContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *NeqExprContext) NEQ() antlr.TerminalNode {
-	return s.GetToken(InscriptParserNEQ, 0)
+func (s *ArrowFunctionContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(InscriptParserIDENTIFIER, 0)
 }
 
-func (s *NeqExprContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterNeqExpr(s)
-	}
+func (s *ArrowFunctionContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(InscriptParserLPAREN, 0)
 }
 
-func (s *NeqExprContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitNeqExpr(s)
-	}
+func (s *ArrowFunctionContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(InscriptParserRPAREN, 0)
 }
 
-func (s *NeqExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ArrowFunctionContext) ParamList() IParamListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IParamListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IParamListContext)
+}
+
+func (s *ArrowFunctionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ArrowFunctionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ArrowFunctionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.EnterArrowFunction(s)
+	}
+}
+
+func (s *ArrowFunctionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.ExitArrowFunction(s)
+	}
+}
+
+func (s *ArrowFunctionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitNeqExpr(s)
+		return t.VisitArrowFunction(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type LtExprContext struct {
+func (p *InscriptParser) ArrowFunction() (localctx IArrowFunctionContext) {
+	localctx = NewArrowFunctionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 374, InscriptParserRULE_arrowFunction)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(396)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case InscriptParserIDENTIFIER:
+		{
+			p.SetState(390)
+			p.Match(InscriptParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case InscriptParserLPAREN:
+		{
+			p.SetState(391)
+			p.Match(InscriptParserLPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(395)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == InscriptParserELLIPSIS || _la == InscriptParserIDENTIFIER {
+			{
+				p.SetState(394)
+				p.ParamList()
+			}
+
+		}
+		{
+			p.SetState(392)
+			p.Match(InscriptParserRPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+	{
+		p.SetState(387)
+		p.Match(InscriptParserARROW)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(388)
+		p.expression(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IExpressionContext is an interface to support dynamic dispatch.
+type IExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+	// IsExpressionContext differentiates from other interfaces.
+	IsExpressionContext()
+}
+
+type ExpressionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExpressionContext() *ExpressionContext {
+	var p = new(ExpressionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = InscriptParserRULE_expression
+	return p
+}
+
+func InitEmptyExpressionContext(p *ExpressionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = InscriptParserRULE_expression
+}
+
+func (*ExpressionContext) IsExpressionContext() {}
+
+func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
+	var p = new(ExpressionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = InscriptParserRULE_expression
+
+	return p
+}
+
+func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExpressionContext) CopyAll(ctx *ExpressionContext) {
+	s.CopyFrom(&ctx.BaseParserRuleContext)
+}
+
+func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type GeExprContext struct {
 	ExpressionContext
 }
 
-func NewLtExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LtExprContext {
-	var p = new(LtExprContext)
+func NewGeExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *GeExprContext {
+	var p = new(GeExprContext)
 
 	InitEmptyExpressionContext(&p.ExpressionContext)
 	p.parser = parser
@@ -4277,11 +4640,11 @@ func NewLtExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LtExprC
 	return p
 }
 
-func (s *LtExprContext) GetRuleContext() antlr.RuleContext {
+func (s *GeExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *LtExprContext) AllExpression() []IExpressionContext {
+func (s *GeExprContext) AllExpression() []IExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -4302,7 +4665,7 @@ func (s *LtExprContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *LtExprContext) Expression(i int) IExpressionContext {
+func (s *GeExprContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -4322,38 +4685,38 @@ func (s *LtExprContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *LtExprContext) LT() antlr.TerminalNode {
-	return s.GetToken(InscriptParserLT, 0)
+func (s *GeExprContext) GE() antlr.TerminalNode {
+	return s.GetToken(InscriptParserGE, 0)
 }
 
-func (s *LtExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GeExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterLtExpr(s)
+		listenerT.EnterGeExpr(s)
 	}
 }
 
-func (s *LtExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GeExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitLtExpr(s)
+		listenerT.ExitGeExpr(s)
 	}
 }
 
-func (s *LtExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *GeExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitLtExpr(s)
+		return t.VisitGeExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type EqExprContext struct {
+type ModExprContext struct {
 	ExpressionContext
 }
 
-func NewEqExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EqExprContext {
-	var p = new(EqExprContext)
+func NewModExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ModExprContext {
+	var p = new(ModExprContext)
 
 	InitEmptyExpressionContext(&p.ExpressionContext)
 	p.parser = parser
@@ -4362,11 +4725,11 @@ func NewEqExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EqExprC
 	return p
 }
 
-func (s *EqExprContext) GetRuleContext() antlr.RuleContext {
+func (s *ModExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *EqExprContext) AllExpression() []IExpressionContext {
+func (s *ModExprContext) AllExpression() []IExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -4387,7 +4750,7 @@ func (s *EqExprContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *EqExprContext) Expression(i int) IExpressionContext {
+func (s *ModExprContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -4407,38 +4770,38 @@ func (s *EqExprContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *EqExprContext) EQ() antlr.TerminalNode {
-	return s.GetToken(InscriptParserEQ, 0)
+func (s *ModExprContext) MOD() antlr.TerminalNode {
+	return s.GetToken(InscriptParserMOD, 0)
 }
 
-func (s *EqExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ModExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterEqExpr(s)
+		listenerT.EnterModExpr(s)
 	}
 }
 
-func (s *EqExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ModExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitEqExpr(s)
+		listenerT.ExitModExpr(s)
 	}
 }
 
-func (s *EqExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ModExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitEqExpr(s)
+		return t.VisitModExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type ExpExprContext struct {
+type GtExprContext struct {
 	ExpressionContext
 }
 
-func NewExpExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ExpExprContext {
-	var p = new(ExpExprContext)
+func NewGtExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *GtExprContext {
+	var p = new(GtExprContext)
 
 	InitEmptyExpressionContext(&p.ExpressionContext)
 	p.parser = parser
@@ -4447,11 +4810,11 @@ func NewExpExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ExpExp
 	return p
 }
 
-func (s *ExpExprContext) GetRuleContext() antlr.RuleContext {
+func (s *GtExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExpExprContext) AllExpression() []IExpressionContext {
+func (s *GtExprContext) AllExpression() []IExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -4472,7 +4835,7 @@ func (s *ExpExprContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *ExpExprContext) Expression(i int) IExpressionContext {
+func (s *GtExprContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -4492,38 +4855,38 @@ func (s *ExpExprContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *ExpExprContext) POW() antlr.TerminalNode {
-	return s.GetToken(InscriptParserPOW, 0)
+func (s *GtExprContext) GT() antlr.TerminalNode {
+	return s.GetToken(InscriptParserGT, 0)
 }
 
-func (s *ExpExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GtExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterExpExpr(s)
+		listenerT.EnterGtExpr(s)
 	}
 }
 
-func (s *ExpExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GtExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitExpExpr(s)
+		listenerT.ExitGtExpr(s)
 	}
 }
 
-func (s *ExpExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *GtExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitExpExpr(s)
+		return t.VisitGtExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type BitandExprContext struct {
+type OrExprContext struct {
 	ExpressionContext
 }
 
-func NewBitandExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitandExprContext {
-	var p = new(BitandExprContext)
+func NewOrExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *OrExprContext {
+	var p = new(OrExprContext)
 
 	InitEmptyExpressionContext(&p.ExpressionContext)
 	p.parser = parser
@@ -4532,11 +4895,11 @@ func NewBitandExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *Bit
 	return p
 }
 
-func (s *BitandExprContext) GetRuleContext() antlr.RuleContext {
+func (s *OrExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BitandExprContext) AllExpression() []IExpressionContext {
+func (s *OrExprContext) AllExpression() []IExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -4557,7 +4920,7 @@ func (s *BitandExprContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *BitandExprContext) Expression(i int) IExpressionContext {
+func (s *OrExprContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -4577,38 +4940,38 @@ func (s *BitandExprContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *BitandExprContext) BITAND() antlr.TerminalNode {
-	return s.GetToken(InscriptParserBITAND, 0)
+func (s *OrExprContext) OR() antlr.TerminalNode {
+	return s.GetToken(InscriptParserOR, 0)
 }
 
-func (s *BitandExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *OrExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterBitandExpr(s)
+		listenerT.EnterOrExpr(s)
 	}
 }
 
-func (s *BitandExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *OrExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitBitandExpr(s)
+		listenerT.ExitOrExpr(s)
 	}
 }
 
-func (s *BitandExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *OrExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitBitandExpr(s)
+		return t.VisitOrExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type AddExprContext struct {
+type IdivExprContext struct {
 	ExpressionContext
 }
 
-func NewAddExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AddExprContext {
-	var p = new(AddExprContext)
+func NewIdivExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IdivExprContext {
+	var p = new(IdivExprContext)
 
 	InitEmptyExpressionContext(&p.ExpressionContext)
 	p.parser = parser
@@ -4617,11 +4980,11 @@ func NewAddExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AddExp
 	return p
 }
 
-func (s *AddExprContext) GetRuleContext() antlr.RuleContext {
+func (s *IdivExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AddExprContext) AllExpression() []IExpressionContext {
+func (s *IdivExprContext) AllExpression() []IExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -4642,7 +5005,7 @@ func (s *AddExprContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *AddExprContext) Expression(i int) IExpressionContext {
+func (s *IdivExprContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -4662,38 +5025,38 @@ func (s *AddExprContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *AddExprContext) ADD() antlr.TerminalNode {
-	return s.GetToken(InscriptParserADD, 0)
+func (s *IdivExprContext) IDIV() antlr.TerminalNode {
+	return s.GetToken(InscriptParserIDIV, 0)
 }
 
-func (s *AddExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *IdivExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterAddExpr(s)
+		listenerT.EnterIdivExpr(s)
 	}
 }
 
-func (s *AddExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *IdivExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitAddExpr(s)
+		listenerT.ExitIdivExpr(s)
 	}
 }
 
-func (s *AddExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *IdivExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitAddExpr(s)
+		return t.VisitIdivExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type LeExprContext struct {
+type SubExprContext struct {
 	ExpressionContext
 }
 
-func NewLeExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LeExprContext {
-	var p = new(LeExprContext)
+func NewSubExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SubExprContext {
+	var p = new(SubExprContext)
 
 	InitEmptyExpressionContext(&p.ExpressionContext)
 	p.parser = parser
@@ -4702,11 +5065,11 @@ func NewLeExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LeExprC
 	return p
 }
 
-func (s *LeExprContext) GetRuleContext() antlr.RuleContext {
+func (s *SubExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *LeExprContext) AllExpression() []IExpressionContext {
+func (s *SubExprContext) AllExpression() []IExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -4727,7 +5090,7 @@ func (s *LeExprContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *LeExprContext) Expression(i int) IExpressionContext {
+func (s *SubExprContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -4747,38 +5110,38 @@ func (s *LeExprContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *LeExprContext) LE() antlr.TerminalNode {
-	return s.GetToken(InscriptParserLE, 0)
+func (s *SubExprContext) SUB() antlr.TerminalNode {
+	return s.GetToken(InscriptParserSUB, 0)
 }
 
-func (s *LeExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SubExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterLeExpr(s)
+		listenerT.EnterSubExpr(s)
 	}
 }
 
-func (s *LeExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SubExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitLeExpr(s)
+		listenerT.ExitSubExpr(s)
 	}
 }
 
-func (s *LeExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SubExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitLeExpr(s)
+		return t.VisitSubExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type BitorExprContext struct {
+type NeqExprContext struct {
 	ExpressionContext
 }
 
-func NewBitorExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitorExprContext {
-	var p = new(BitorExprContext)
+func NewNeqExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NeqExprContext {
+	var p = new(NeqExprContext)
 
 	InitEmptyExpressionContext(&p.ExpressionContext)
 	p.parser = parser
@@ -4787,11 +5150,11 @@ func NewBitorExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *Bito
 	return p
 }
 
-func (s *BitorExprContext) GetRuleContext() antlr.RuleContext {
+func (s *NeqExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BitorExprContext) AllExpression() []IExpressionContext {
+func (s *NeqExprContext) AllExpression() []IExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -4812,7 +5175,7 @@ func (s *BitorExprContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *BitorExprContext) Expression(i int) IExpressionContext {
+func (s *NeqExprContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -4832,38 +5195,38 @@ func (s *BitorExprContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *BitorExprContext) BITOR() antlr.TerminalNode {
-	return s.GetToken(InscriptParserBITOR, 0)
+func (s *NeqExprContext) NEQ() antlr.TerminalNode {
+	return s.GetToken(InscriptParserNEQ, 0)
 }
 
-func (s *BitorExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *NeqExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterBitorExpr(s)
+		listenerT.EnterNeqExpr(s)
 	}
 }
 
-func (s *BitorExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *NeqExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitBitorExpr(s)
+		listenerT.ExitNeqExpr(s)
 	}
 }
 
-func (s *BitorExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *NeqExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitBitorExpr(s)
+		return t.VisitNeqExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type BitxorExprContext struct {
+type LtExprContext struct {
 	ExpressionContext
 }
 
-func NewBitxorExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitxorExprContext {
-	var p = new(BitxorExprContext)
+func NewLtExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LtExprContext {
+	var p = new(LtExprContext)
 
 	InitEmptyExpressionContext(&p.ExpressionContext)
 	p.parser = parser
@@ -4872,11 +5235,11 @@ func NewBitxorExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *Bit
 	return p
 }
 
-func (s *BitxorExprContext) GetRuleContext() antlr.RuleContext {
+func (s *LtExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BitxorExprContext) AllExpression() []IExpressionContext {
+func (s *LtExprContext) AllExpression() []IExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -4897,7 +5260,7 @@ func (s *BitxorExprContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *BitxorExprContext) Expression(i int) IExpressionContext {
+func (s *LtExprContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -4917,38 +5280,38 @@ func (s *BitxorExprContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *BitxorExprContext) BITXOR() antlr.TerminalNode {
-	return s.GetToken(InscriptParserBITXOR, 0)
+func (s *LtExprContext) LT() antlr.TerminalNode {
+	return s.GetToken(InscriptParserLT, 0)
 }
 
-func (s *BitxorExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *LtExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterBitxorExpr(s)
+		listenerT.EnterLtExpr(s)
 	}
 }
 
-func (s *BitxorExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *LtExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitBitxorExpr(s)
+		listenerT.ExitLtExpr(s)
 	}
 }
 
-func (s *BitxorExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *LtExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitBitxorExpr(s)
+		return t.VisitLtExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type MulExprContext struct {
+type EqExprContext struct {
 	ExpressionContext
 }
 
-func NewMulExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MulExprContext {
-	var p = new(MulExprContext)
+func NewEqExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EqExprContext {
+	var p = new(EqExprContext)
 
 	InitEmptyExpressionContext(&p.ExpressionContext)
 	p.parser = parser
@@ -4957,11 +5320,11 @@ func NewMulExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MulExp
 	return p
 }
 
-func (s *MulExprContext) GetRuleContext() antlr.RuleContext {
+func (s *EqExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MulExprContext) AllExpression() []IExpressionContext {
+func (s *EqExprContext) AllExpression() []IExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -4982,7 +5345,7 @@ func (s *MulExprContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *MulExprContext) Expression(i int) IExpressionContext {
+func (s *EqExprContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -5002,38 +5365,38 @@ func (s *MulExprContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *MulExprContext) MUL() antlr.TerminalNode {
-	return s.GetToken(InscriptParserMUL, 0)
+func (s *EqExprContext) EQ() antlr.TerminalNode {
+	return s.GetToken(InscriptParserEQ, 0)
 }
 
-func (s *MulExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *EqExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterMulExpr(s)
+		listenerT.EnterEqExpr(s)
 	}
 }
 
-func (s *MulExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *EqExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitMulExpr(s)
+		listenerT.ExitEqExpr(s)
 	}
 }
 
-func (s *MulExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *EqExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitMulExpr(s)
+		return t.VisitEqExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type DivExprContext struct {
+type ExpExprContext struct {
 	ExpressionContext
 }
 
-func NewDivExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DivExprContext {
-	var p = new(DivExprContext)
+func NewExpExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ExpExprContext {
+	var p = new(ExpExprContext)
 
 	InitEmptyExpressionContext(&p.ExpressionContext)
 	p.parser = parser
@@ -5042,11 +5405,11 @@ func NewDivExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DivExp
 	return p
 }
 
-func (s *DivExprContext) GetRuleContext() antlr.RuleContext {
+func (s *ExpExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DivExprContext) AllExpression() []IExpressionContext {
+func (s *ExpExprContext) AllExpression() []IExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -5067,7 +5430,7 @@ func (s *DivExprContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *DivExprContext) Expression(i int) IExpressionContext {
+func (s *ExpExprContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -5087,38 +5450,38 @@ func (s *DivExprContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *DivExprContext) DIV() antlr.TerminalNode {
-	return s.GetToken(InscriptParserDIV, 0)
+func (s *ExpExprContext) POW() antlr.TerminalNode {
+	return s.GetToken(InscriptParserPOW, 0)
 }
 
-func (s *DivExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ExpExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterDivExpr(s)
+		listenerT.EnterExpExpr(s)
 	}
 }
 
-func (s *DivExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ExpExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitDivExpr(s)
+		listenerT.ExitExpExpr(s)
 	}
 }
 
-func (s *DivExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ExpExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitDivExpr(s)
+		return t.VisitExpExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type ShlExprContext struct {
+type BitandExprContext struct {
 	ExpressionContext
 }
 
-func NewShlExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ShlExprContext {
-	var p = new(ShlExprContext)
+func NewBitandExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitandExprContext {
+	var p = new(BitandExprContext)
 
 	InitEmptyExpressionContext(&p.ExpressionContext)
 	p.parser = parser
@@ -5127,11 +5490,11 @@ func NewShlExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ShlExp
 	return p
 }
 
-func (s *ShlExprContext) GetRuleContext() antlr.RuleContext {
+func (s *BitandExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShlExprContext) AllExpression() []IExpressionContext {
+func (s *BitandExprContext) AllExpression() []IExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -5152,7 +5515,7 @@ func (s *ShlExprContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *ShlExprContext) Expression(i int) IExpressionContext {
+func (s *BitandExprContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -5172,38 +5535,38 @@ func (s *ShlExprContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *ShlExprContext) SHL() antlr.TerminalNode {
-	return s.GetToken(InscriptParserSHL, 0)
+func (s *BitandExprContext) BITAND() antlr.TerminalNode {
+	return s.GetToken(InscriptParserBITAND, 0)
 }
 
-func (s *ShlExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BitandExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterShlExpr(s)
+		listenerT.EnterBitandExpr(s)
 	}
 }
 
-func (s *ShlExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BitandExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitShlExpr(s)
+		listenerT.ExitBitandExpr(s)
 	}
 }
 
-func (s *ShlExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *BitandExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitShlExpr(s)
+		return t.VisitBitandExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type UnaryExpressionContext struct {
+type AddExprContext struct {
 	ExpressionContext
 }
 
-func NewUnaryExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *UnaryExpressionContext {
-	var p = new(UnaryExpressionContext)
+func NewAddExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AddExprContext {
+	var p = new(AddExprContext)
 
 	InitEmptyExpressionContext(&p.ExpressionContext)
 	p.parser = parser
@@ -5212,16 +5575,41 @@ func NewUnaryExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext)
 	return p
 }
 
-func (s *UnaryExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *AddExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *UnaryExpressionContext) UnaryExpr() IUnaryExprContext {
+func (s *AddExprContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *AddExprContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IUnaryExprContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -5229,37 +5617,41 @@ func (s *UnaryExpressionContext) UnaryExpr() IUnaryExprContext {
 		return nil
 	}
 
-	return t.(IUnaryExprContext)
+	return t.(IExpressionContext)
 }
 
-func (s *UnaryExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterUnaryExpression(s)
-	}
+func (s *AddExprContext) ADD() antlr.TerminalNode {
+	return s.GetToken(InscriptParserADD, 0)
 }
 
-func (s *UnaryExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AddExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitUnaryExpression(s)
+		listenerT.EnterAddExpr(s)
 	}
 }
 
-func (s *UnaryExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AddExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.ExitAddExpr(s)
+	}
+}
+
+func (s *AddExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitUnaryExpression(s)
+		return t.VisitAddExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type ShrExprContext struct {
+type LeExprContext struct {
 	ExpressionContext
 }
 
-func NewShrExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ShrExprContext {
-	var p = new(ShrExprContext)
+func NewLeExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LeExprContext {
+	var p = new(LeExprContext)
 
 	InitEmptyExpressionContext(&p.ExpressionContext)
 	p.parser = parser
@@ -5268,11 +5660,11 @@ func NewShrExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ShrExp
 	return p
 }
 
-func (s *ShrExprContext) GetRuleContext() antlr.RuleContext {
+func (s *LeExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShrExprContext) AllExpression() []IExpressionContext {
+func (s *LeExprContext) AllExpression() []IExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -5293,7 +5685,7 @@ func (s *ShrExprContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *ShrExprContext) Expression(i int) IExpressionContext {
+func (s *LeExprContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -5313,38 +5705,38 @@ func (s *ShrExprContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *ShrExprContext) SHR() antlr.TerminalNode {
-	return s.GetToken(InscriptParserSHR, 0)
+func (s *LeExprContext) LE() antlr.TerminalNode {
+	return s.GetToken(InscriptParserLE, 0)
 }
 
-func (s *ShrExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *LeExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterShrExpr(s)
+		listenerT.EnterLeExpr(s)
 	}
 }
 
-func (s *ShrExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *LeExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitShrExpr(s)
+		listenerT.ExitLeExpr(s)
 	}
 }
 
-func (s *ShrExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *LeExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitShrExpr(s)
+		return t.VisitLeExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type AndExprContext struct {
+type BitorExprContext struct {
 	ExpressionContext
 }
 
-func NewAndExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AndExprContext {
-	var p = new(AndExprContext)
+func NewBitorExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitorExprContext {
+	var p = new(BitorExprContext)
 
 	InitEmptyExpressionContext(&p.ExpressionContext)
 	p.parser = parser
@@ -5353,11 +5745,11 @@ func NewAndExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AndExp
 	return p
 }
 
-func (s *AndExprContext) GetRuleContext() antlr.RuleContext {
+func (s *BitorExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AndExprContext) AllExpression() []IExpressionContext {
+func (s *BitorExprContext) AllExpression() []IExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -5378,7 +5770,7 @@ func (s *AndExprContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *AndExprContext) Expression(i int) IExpressionContext {
+func (s *BitorExprContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -5398,534 +5790,1474 @@ func (s *AndExprContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *AndExprContext) AND() antlr.TerminalNode {
-	return s.GetToken(InscriptParserAND, 0)
+func (s *BitorExprContext) BITOR() antlr.TerminalNode {
+	return s.GetToken(InscriptParserBITOR, 0)
 }
 
-func (s *AndExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BitorExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterAndExpr(s)
+		listenerT.EnterBitorExpr(s)
 	}
 }
 
-func (s *AndExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BitorExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitAndExpr(s)
+		listenerT.ExitBitorExpr(s)
 	}
 }
 
-func (s *AndExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *BitorExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitAndExpr(s)
+		return t.VisitBitorExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *InscriptParser) Expression() (localctx IExpressionContext) {
-	return p.expression(0)
+type BitxorExprContext struct {
+	ExpressionContext
 }
 
-func (p *InscriptParser) expression(_p int) (localctx IExpressionContext) {
-	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+func NewBitxorExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitxorExprContext {
+	var p = new(BitxorExprContext)
 
-	_parentState := p.GetState()
-	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
-	var _prevctx IExpressionContext = localctx
-	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 36
-	p.EnterRecursionRule(localctx, 36, InscriptParserRULE_expression, _p)
-	var _alt int
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
 
-	p.EnterOuterAlt(localctx, 1)
-	localctx = NewUnaryExpressionContext(p, localctx)
-	p.SetParserRuleContext(localctx)
-	_prevctx = localctx
+	return p
+}
 
-	{
-		p.SetState(187)
-		p.UnaryExpr()
-	}
+func (s *BitxorExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(251)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 16, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
+func (s *BitxorExprContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
 	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			if p.GetParseListeners() != nil {
-				p.TriggerExitRuleEvent()
-			}
-			_prevctx = localctx
-			p.SetState(249)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
 
-			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 15, p.GetParserRuleContext()) {
-			case 1:
-				localctx = NewExpExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(189)
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 20)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 20)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(190)
-					p.Match(InscriptParserPOW)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(191)
-					p.expression(21)
-				}
+	return tst
+}
 
-			case 2:
-				localctx = NewMulExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(192)
+func (s *BitxorExprContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 19)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 19)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(193)
-					p.Match(InscriptParserMUL)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(194)
-					p.expression(20)
-				}
+	if t == nil {
+		return nil
+	}
 
-			case 3:
-				localctx = NewDivExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(195)
+	return t.(IExpressionContext)
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 18)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 18)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(196)
-					p.Match(InscriptParserDIV)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(197)
-					p.expression(19)
-				}
+func (s *BitxorExprContext) BITXOR() antlr.TerminalNode {
+	return s.GetToken(InscriptParserBITXOR, 0)
+}
 
-			case 4:
-				localctx = NewIdivExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(198)
+func (s *BitxorExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.EnterBitxorExpr(s)
+	}
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 17)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 17)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(199)
-					p.Match(InscriptParserIDIV)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(200)
-					p.expression(18)
-				}
+func (s *BitxorExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.ExitBitxorExpr(s)
+	}
+}
 
-			case 5:
-				localctx = NewModExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(201)
+func (s *BitxorExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case InscriptVisitor:
+		return t.VisitBitxorExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type MulExprContext struct {
+	ExpressionContext
+}
+
+func NewMulExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MulExprContext {
+	var p = new(MulExprContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *MulExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MulExprContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *MulExprContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *MulExprContext) MUL() antlr.TerminalNode {
+	return s.GetToken(InscriptParserMUL, 0)
+}
+
+func (s *MulExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.EnterMulExpr(s)
+	}
+}
+
+func (s *MulExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.ExitMulExpr(s)
+	}
+}
+
+func (s *MulExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case InscriptVisitor:
+		return t.VisitMulExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type DivExprContext struct {
+	ExpressionContext
+}
+
+func NewDivExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DivExprContext {
+	var p = new(DivExprContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *DivExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DivExprContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *DivExprContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *DivExprContext) DIV() antlr.TerminalNode {
+	return s.GetToken(InscriptParserDIV, 0)
+}
+
+func (s *DivExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.EnterDivExpr(s)
+	}
+}
+
+func (s *DivExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.ExitDivExpr(s)
+	}
+}
+
+func (s *DivExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case InscriptVisitor:
+		return t.VisitDivExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ShlExprContext struct {
+	ExpressionContext
+}
+
+func NewShlExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ShlExprContext {
+	var p = new(ShlExprContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *ShlExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShlExprContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ShlExprContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ShlExprContext) SHL() antlr.TerminalNode {
+	return s.GetToken(InscriptParserSHL, 0)
+}
+
+func (s *ShlExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.EnterShlExpr(s)
+	}
+}
+
+func (s *ShlExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.ExitShlExpr(s)
+	}
+}
+
+func (s *ShlExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case InscriptVisitor:
+		return t.VisitShlExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type UnaryExpressionContext struct {
+	ExpressionContext
+}
+
+func NewUnaryExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *UnaryExpressionContext {
+	var p = new(UnaryExpressionContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *UnaryExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UnaryExpressionContext) UnaryExpr() IUnaryExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUnaryExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUnaryExprContext)
+}
+
+func (s *UnaryExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.EnterUnaryExpression(s)
+	}
+}
+
+func (s *UnaryExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.ExitUnaryExpression(s)
+	}
+}
+
+func (s *UnaryExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case InscriptVisitor:
+		return t.VisitUnaryExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ShrExprContext struct {
+	ExpressionContext
+}
+
+func NewShrExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ShrExprContext {
+	var p = new(ShrExprContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *ShrExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShrExprContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ShrExprContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ShrExprContext) SHR() antlr.TerminalNode {
+	return s.GetToken(InscriptParserSHR, 0)
+}
+
+func (s *ShrExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.EnterShrExpr(s)
+	}
+}
+
+func (s *ShrExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.ExitShrExpr(s)
+	}
+}
+
+func (s *ShrExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case InscriptVisitor:
+		return t.VisitShrExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type AndExprContext struct {
+	ExpressionContext
+}
+
+func NewAndExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AndExprContext {
+	var p = new(AndExprContext)
+
+	InitEmptyExpressionContext(&p.ExpressionContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *AndExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AndExprContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *AndExprContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *AndExprContext) AND() antlr.TerminalNode {
+	return s.GetToken(InscriptParserAND, 0)
+}
+
+func (s *AndExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.EnterAndExpr(s)
+	}
+}
+
+func (s *AndExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.ExitAndExpr(s)
+	}
+}
+
+func (s *AndExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case InscriptVisitor:
+		return t.VisitAndExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *InscriptParser) Expression() (localctx IExpressionContext) {
+	return p.expression(0)
+}
+
+func (p *InscriptParser) expression(_p int) (localctx IExpressionContext) {
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+
+	_parentState := p.GetState()
+	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IExpressionContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 36
+	p.EnterRecursionRule(localctx, 36, InscriptParserRULE_expression, _p)
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	localctx = NewUnaryExpressionContext(p, localctx)
+	p.SetParserRuleContext(localctx)
+	_prevctx = localctx
+
+	{
+		p.SetState(187)
+		p.UnaryExpr()
+	}
+
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(251)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 16, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
+			}
+			_prevctx = localctx
+			p.SetState(249)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 15, p.GetParserRuleContext()) {
+			case 1:
+				localctx = NewExpExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
+				p.SetState(189)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 20)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 20)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(190)
+					p.Match(InscriptParserPOW)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(191)
+					p.expression(21)
+				}
+
+			case 2:
+				localctx = NewMulExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
+				p.SetState(192)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 19)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 19)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(193)
+					p.Match(InscriptParserMUL)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(194)
+					p.expression(20)
+				}
+
+			case 3:
+				localctx = NewDivExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
+				p.SetState(195)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 18)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 18)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(196)
+					p.Match(InscriptParserDIV)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(197)
+					p.expression(19)
+				}
+
+			case 4:
+				localctx = NewIdivExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
+				p.SetState(198)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 17)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 17)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(199)
+					p.Match(InscriptParserIDIV)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(200)
+					p.expression(18)
+				}
+
+			case 5:
+				localctx = NewModExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
+				p.SetState(201)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(202)
-					p.Match(InscriptParserMOD)
+					p.SetState(202)
+					p.Match(InscriptParserMOD)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(203)
+					p.expression(17)
+				}
+
+			case 6:
+				localctx = NewAddExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
+				p.SetState(204)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 15)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 15)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(205)
+					p.Match(InscriptParserADD)
 					if p.HasError() {
 						// Recognition error - abort rule
 						goto errorExit
 					}
 				}
 				{
-					p.SetState(203)
-					p.expression(17)
+					p.SetState(206)
+					p.expression(16)
 				}
 
-			case 6:
-				localctx = NewAddExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+			case 7:
+				localctx = NewSubExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
+				p.SetState(207)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 14)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 14)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(208)
+					p.Match(InscriptParserSUB)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(209)
+					p.expression(15)
+				}
+
+			case 8:
+				localctx = NewBitandExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
+				p.SetState(210)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 13)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 13)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(211)
+					p.Match(InscriptParserBITAND)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(212)
+					p.expression(14)
+				}
+
+			case 9:
+				localctx = NewBitorExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
+				p.SetState(213)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 12)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 12)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(214)
+					p.Match(InscriptParserBITOR)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(215)
+					p.expression(13)
+				}
+
+			case 10:
+				localctx = NewBitxorExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
+				p.SetState(216)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 11)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 11)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(217)
+					p.Match(InscriptParserBITXOR)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(218)
+					p.expression(12)
+				}
+
+			case 11:
+				localctx = NewShlExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
+				p.SetState(219)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(220)
+					p.Match(InscriptParserSHL)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(221)
+					p.expression(11)
+				}
+
+			case 12:
+				localctx = NewShrExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
+				p.SetState(222)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(223)
+					p.Match(InscriptParserSHR)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(224)
+					p.expression(10)
+				}
+
+			case 13:
+				localctx = NewLtExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
+				p.SetState(225)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(226)
+					p.Match(InscriptParserLT)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(227)
+					p.expression(9)
+				}
+
+			case 14:
+				localctx = NewLeExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
+				p.SetState(228)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(229)
+					p.Match(InscriptParserLE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(230)
+					p.expression(8)
+				}
+
+			case 15:
+				localctx = NewGtExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
+				p.SetState(231)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(232)
+					p.Match(InscriptParserGT)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(233)
+					p.expression(7)
+				}
+
+			case 16:
+				localctx = NewGeExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
+				p.SetState(234)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(235)
+					p.Match(InscriptParserGE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(236)
+					p.expression(6)
+				}
+
+			case 17:
+				localctx = NewEqExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
+				p.SetState(237)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(238)
+					p.Match(InscriptParserEQ)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(239)
+					p.expression(5)
+				}
+
+			case 18:
+				localctx = NewNeqExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(204)
+				p.SetState(240)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 15)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 15)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(205)
-					p.Match(InscriptParserADD)
+					p.SetState(241)
+					p.Match(InscriptParserNEQ)
 					if p.HasError() {
 						// Recognition error - abort rule
 						goto errorExit
 					}
 				}
 				{
-					p.SetState(206)
-					p.expression(16)
+					p.SetState(242)
+					p.expression(4)
 				}
 
-			case 7:
-				localctx = NewSubExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+			case 19:
+				localctx = NewAndExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(207)
+				p.SetState(243)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 14)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 14)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(208)
-					p.Match(InscriptParserSUB)
+					p.SetState(244)
+					p.Match(InscriptParserAND)
 					if p.HasError() {
 						// Recognition error - abort rule
 						goto errorExit
 					}
 				}
 				{
-					p.SetState(209)
-					p.expression(15)
+					p.SetState(245)
+					p.expression(3)
 				}
 
-			case 8:
-				localctx = NewBitandExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
+			case 20:
+				localctx = NewOrExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(210)
+				p.SetState(246)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 13)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 13)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(211)
-					p.Match(InscriptParserBITAND)
+					p.SetState(247)
+					p.Match(InscriptParserOR)
 					if p.HasError() {
 						// Recognition error - abort rule
 						goto errorExit
 					}
 				}
 				{
-					p.SetState(212)
-					p.expression(14)
+					p.SetState(248)
+					p.expression(2)
 				}
 
-			case 9:
-				localctx = NewBitorExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(213)
+			case antlr.ATNInvalidAltNumber:
+				goto errorExit
+			}
+
+		}
+		p.SetState(253)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 16, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.UnrollRecursionContexts(_parentctx)
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IUnaryExprContext is an interface to support dynamic dispatch.
+type IUnaryExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+	// IsUnaryExprContext differentiates from other interfaces.
+	IsUnaryExprContext()
+}
+
+type UnaryExprContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyUnaryExprContext() *UnaryExprContext {
+	var p = new(UnaryExprContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = InscriptParserRULE_unaryExpr
+	return p
+}
+
+func InitEmptyUnaryExprContext(p *UnaryExprContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = InscriptParserRULE_unaryExpr
+}
+
+func (*UnaryExprContext) IsUnaryExprContext() {}
+
+func NewUnaryExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnaryExprContext {
+	var p = new(UnaryExprContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = InscriptParserRULE_unaryExpr
+
+	return p
+}
+
+func (s *UnaryExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *UnaryExprContext) CopyAll(ctx *UnaryExprContext) {
+	s.CopyFrom(&ctx.BaseParserRuleContext)
+}
+
+func (s *UnaryExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UnaryExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type NotExprContext struct {
+	UnaryExprContext
+}
+
+func NewNotExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NotExprContext {
+	var p = new(NotExprContext)
+
+	InitEmptyUnaryExprContext(&p.UnaryExprContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*UnaryExprContext))
+
+	return p
+}
+
+func (s *NotExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NotExprContext) NOT() antlr.TerminalNode {
+	return s.GetToken(InscriptParserNOT, 0)
+}
+
+func (s *NotExprContext) UnaryExpr() IUnaryExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUnaryExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUnaryExprContext)
+}
+
+func (s *NotExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.EnterNotExpr(s)
+	}
+}
+
+func (s *NotExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.ExitNotExpr(s)
+	}
+}
+
+func (s *NotExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case InscriptVisitor:
+		return t.VisitNotExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type NegExprContext struct {
+	UnaryExprContext
+}
+
+func NewNegExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NegExprContext {
+	var p = new(NegExprContext)
+
+	InitEmptyUnaryExprContext(&p.UnaryExprContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*UnaryExprContext))
+
+	return p
+}
+
+func (s *NegExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NegExprContext) SUB() antlr.TerminalNode {
+	return s.GetToken(InscriptParserSUB, 0)
+}
+
+func (s *NegExprContext) UnaryExpr() IUnaryExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUnaryExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUnaryExprContext)
+}
+
+func (s *NegExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.EnterNegExpr(s)
+	}
+}
+
+func (s *NegExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.ExitNegExpr(s)
+	}
+}
+
+func (s *NegExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case InscriptVisitor:
+		return t.VisitNegExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type BitnotExprContext struct {
+	UnaryExprContext
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 12)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 12)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(214)
-					p.Match(InscriptParserBITOR)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(215)
-					p.expression(13)
-				}
+func NewBitnotExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitnotExprContext {
+	var p = new(BitnotExprContext)
 
-			case 10:
-				localctx = NewBitxorExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(216)
+	InitEmptyUnaryExprContext(&p.UnaryExprContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*UnaryExprContext))
 
-				if !(p.Precpred(p.GetParserRuleContext(), 11)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 11)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(217)
-					p.Match(InscriptParserBITXOR)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(218)
-					p.expression(12)
-				}
+	return p
+}
 
-			case 11:
-				localctx = NewShlExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(219)
+func (s *BitnotExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(220)
-					p.Match(InscriptParserSHL)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(221)
-					p.expression(11)
-				}
+func (s *BitnotExprContext) BITNOT() antlr.TerminalNode {
+	return s.GetToken(InscriptParserBITNOT, 0)
+}
 
-			case 12:
-				localctx = NewShrExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(222)
+func (s *BitnotExprContext) UnaryExpr() IUnaryExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUnaryExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(223)
-					p.Match(InscriptParserSHR)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(224)
-					p.expression(10)
-				}
+	if t == nil {
+		return nil
+	}
 
-			case 13:
-				localctx = NewLtExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(225)
+	return t.(IUnaryExprContext)
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(226)
-					p.Match(InscriptParserLT)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(227)
-					p.expression(9)
-				}
+func (s *BitnotExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.EnterBitnotExpr(s)
+	}
+}
 
-			case 14:
-				localctx = NewLeExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(228)
+func (s *BitnotExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.ExitBitnotExpr(s)
+	}
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(229)
-					p.Match(InscriptParserLE)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(230)
-					p.expression(8)
-				}
+func (s *BitnotExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case InscriptVisitor:
+		return t.VisitBitnotExpr(s)
 
-			case 15:
-				localctx = NewGtExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(231)
+	default:
+		return t.VisitChildren(s)
+	}
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(232)
-					p.Match(InscriptParserGT)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(233)
-					p.expression(7)
-				}
+type PostfixExpressionContext struct {
+	UnaryExprContext
+}
 
-			case 16:
-				localctx = NewGeExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(234)
+func NewPostfixExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PostfixExpressionContext {
+	var p = new(PostfixExpressionContext)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(235)
-					p.Match(InscriptParserGE)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(236)
-					p.expression(6)
-				}
+	InitEmptyUnaryExprContext(&p.UnaryExprContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*UnaryExprContext))
 
-			case 17:
-				localctx = NewEqExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(237)
+	return p
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(238)
-					p.Match(InscriptParserEQ)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(239)
-					p.expression(5)
-				}
+func (s *PostfixExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PostfixExpressionContext) PostfixExpr() IPostfixExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPostfixExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPostfixExprContext)
+}
 
-			case 18:
-				localctx = NewNeqExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(240)
+func (s *PostfixExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.EnterPostfixExpression(s)
+	}
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(241)
-					p.Match(InscriptParserNEQ)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(242)
-					p.expression(4)
-				}
+func (s *PostfixExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(InscriptListener); ok {
+		listenerT.ExitPostfixExpression(s)
+	}
+}
 
-			case 19:
-				localctx = NewAndExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(243)
+func (s *PostfixExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case InscriptVisitor:
+		return t.VisitPostfixExpression(s)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(244)
-					p.Match(InscriptParserAND)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(245)
-					p.expression(3)
-				}
+	default:
+		return t.VisitChildren(s)
+	}
+}
 
-			case 20:
-				localctx = NewOrExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_expression)
-				p.SetState(246)
+func (p *InscriptParser) UnaryExpr() (localctx IUnaryExprContext) {
+	localctx = NewUnaryExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, InscriptParserRULE_unaryExpr)
+	p.SetState(261)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(247)
-					p.Match(InscriptParserOR)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(248)
-					p.expression(2)
-				}
+	switch p.GetTokenStream().LA(1) {
+	case InscriptParserNOT:
+		localctx = NewNotExprContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(254)
+			p.Match(InscriptParserNOT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(255)
+			p.UnaryExpr()
+		}
 
-			case antlr.ATNInvalidAltNumber:
+	case InscriptParserBITNOT:
+		localctx = NewBitnotExprContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(256)
+			p.Match(InscriptParserBITNOT)
+			if p.HasError() {
+				// Recognition error - abort rule
 				goto errorExit
 			}
+		}
+		{
+			p.SetState(257)
+			p.UnaryExpr()
+		}
 
+	case InscriptParserSUB:
+		localctx = NewNegExprContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(258)
+			p.Match(InscriptParserSUB)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-		p.SetState(253)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+		{
+			p.SetState(259)
+			p.UnaryExpr()
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 16, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
+
+	case InscriptParserFUNCTION, InscriptParserTRUE, InscriptParserFALSE, InscriptParserNIL, InscriptParserLPAREN, InscriptParserLBRACK, InscriptParserLBRACE, InscriptParserIDENTIFIER, InscriptParserNUMBER, InscriptParserSTRING:
+		localctx = NewPostfixExpressionContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(260)
+			p.postfixExpr(0)
 		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -5936,91 +7268,87 @@ errorExit:
 		p.GetErrorHandler().Recover(p, v)
 		p.SetError(nil)
 	}
-	p.UnrollRecursionContexts(_parentctx)
+	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IUnaryExprContext is an interface to support dynamic dispatch.
-type IUnaryExprContext interface {
+// IPostfixExprContext is an interface to support dynamic dispatch.
+type IPostfixExprContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-	// IsUnaryExprContext differentiates from other interfaces.
-	IsUnaryExprContext()
+	// IsPostfixExprContext differentiates from other interfaces.
+	IsPostfixExprContext()
 }
 
-type UnaryExprContext struct {
+type PostfixExprContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyUnaryExprContext() *UnaryExprContext {
-	var p = new(UnaryExprContext)
+func NewEmptyPostfixExprContext() *PostfixExprContext {
+	var p = new(PostfixExprContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = InscriptParserRULE_unaryExpr
+	p.RuleIndex = InscriptParserRULE_postfixExpr
 	return p
 }
 
-func InitEmptyUnaryExprContext(p *UnaryExprContext) {
+func InitEmptyPostfixExprContext(p *PostfixExprContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = InscriptParserRULE_unaryExpr
+	p.RuleIndex = InscriptParserRULE_postfixExpr
 }
 
-func (*UnaryExprContext) IsUnaryExprContext() {}
+func (*PostfixExprContext) IsPostfixExprContext() {}
 
-func NewUnaryExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnaryExprContext {
-	var p = new(UnaryExprContext)
+func NewPostfixExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PostfixExprContext {
+	var p = new(PostfixExprContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = InscriptParserRULE_unaryExpr
+	p.RuleIndex = InscriptParserRULE_postfixExpr
 
 	return p
 }
 
-func (s *UnaryExprContext) GetParser() antlr.Parser { return s.parser }
+func (s *PostfixExprContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *UnaryExprContext) CopyAll(ctx *UnaryExprContext) {
+func (s *PostfixExprContext) CopyAll(ctx *PostfixExprContext) {
 	s.CopyFrom(&ctx.BaseParserRuleContext)
 }
 
-func (s *UnaryExprContext) GetRuleContext() antlr.RuleContext {
+func (s *PostfixExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *UnaryExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PostfixExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-type NotExprContext struct {
-	UnaryExprContext
+type PrimaryPostfixContext struct {
+	PostfixExprContext
 }
 
-func NewNotExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NotExprContext {
-	var p = new(NotExprContext)
+func NewPrimaryPostfixContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PrimaryPostfixContext {
+	var p = new(PrimaryPostfixContext)
 
-	InitEmptyUnaryExprContext(&p.UnaryExprContext)
+	InitEmptyPostfixExprContext(&p.PostfixExprContext)
 	p.parser = parser
-	p.CopyAll(ctx.(*UnaryExprContext))
+	p.CopyAll(ctx.(*PostfixExprContext))
 
 	return p
 }
 
-func (s *NotExprContext) GetRuleContext() antlr.RuleContext {
+func (s *PrimaryPostfixContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NotExprContext) NOT() antlr.TerminalNode {
-	return s.GetToken(InscriptParserNOT, 0)
-}
-
-func (s *NotExprContext) UnaryExpr() IUnaryExprContext {
+func (s *PrimaryPostfixContext) Primary() IPrimaryContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IUnaryExprContext); ok {
+		if _, ok := ctx.(IPrimaryContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6030,57 +7358,73 @@ func (s *NotExprContext) UnaryExpr() IUnaryExprContext {
 		return nil
 	}
 
-	return t.(IUnaryExprContext)
+	return t.(IPrimaryContext)
 }
 
-func (s *NotExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PrimaryPostfixContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterNotExpr(s)
+		listenerT.EnterPrimaryPostfix(s)
 	}
 }
 
-func (s *NotExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PrimaryPostfixContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitNotExpr(s)
+		listenerT.ExitPrimaryPostfix(s)
 	}
 }
 
-func (s *NotExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PrimaryPostfixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitNotExpr(s)
+		return t.VisitPrimaryPostfix(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type NegExprContext struct {
-	UnaryExprContext
+type IndexPostfixContext struct {
+	PostfixExprContext
 }
 
-func NewNegExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NegExprContext {
-	var p = new(NegExprContext)
+func NewIndexPostfixContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IndexPostfixContext {
+	var p = new(IndexPostfixContext)
 
-	InitEmptyUnaryExprContext(&p.UnaryExprContext)
+	InitEmptyPostfixExprContext(&p.PostfixExprContext)
 	p.parser = parser
-	p.CopyAll(ctx.(*UnaryExprContext))
+	p.CopyAll(ctx.(*PostfixExprContext))
 
 	return p
 }
 
-func (s *NegExprContext) GetRuleContext() antlr.RuleContext {
+func (s *IndexPostfixContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NegExprContext) SUB() antlr.TerminalNode {
-	return s.GetToken(InscriptParserSUB, 0)
+func (s *IndexPostfixContext) PostfixExpr() IPostfixExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPostfixExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPostfixExprContext)
 }
 
-func (s *NegExprContext) UnaryExpr() IUnaryExprContext {
+func (s *IndexPostfixContext) LBRACK() antlr.TerminalNode {
+	return s.GetToken(InscriptParserLBRACK, 0)
+}
+
+func (s *IndexPostfixContext) Expression() IExpressionContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IUnaryExprContext); ok {
+		if _, ok := ctx.(IExpressionContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6090,57 +7434,57 @@ func (s *NegExprContext) UnaryExpr() IUnaryExprContext {
 		return nil
 	}
 
-	return t.(IUnaryExprContext)
+	return t.(IExpressionContext)
 }
 
-func (s *NegExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *IndexPostfixContext) RBRACK() antlr.TerminalNode {
+	return s.GetToken(InscriptParserRBRACK, 0)
+}
+
+func (s *IndexPostfixContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterNegExpr(s)
+		listenerT.EnterIndexPostfix(s)
 	}
 }
 
-func (s *NegExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *IndexPostfixContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitNegExpr(s)
+		listenerT.ExitIndexPostfix(s)
 	}
 }
 
-func (s *NegExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *IndexPostfixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitNegExpr(s)
+		return t.VisitIndexPostfix(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type BitnotExprContext struct {
-	UnaryExprContext
+type AttrPostfixContext struct {
+	PostfixExprContext
 }
 
-func NewBitnotExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitnotExprContext {
-	var p = new(BitnotExprContext)
+func NewAttrPostfixContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AttrPostfixContext {
+	var p = new(AttrPostfixContext)
 
-	InitEmptyUnaryExprContext(&p.UnaryExprContext)
+	InitEmptyPostfixExprContext(&p.PostfixExprContext)
 	p.parser = parser
-	p.CopyAll(ctx.(*UnaryExprContext))
+	p.CopyAll(ctx.(*PostfixExprContext))
 
 	return p
 }
 
-func (s *BitnotExprContext) GetRuleContext() antlr.RuleContext {
+func (s *AttrPostfixContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BitnotExprContext) BITNOT() antlr.TerminalNode {
-	return s.GetToken(InscriptParserBITNOT, 0)
-}
-
-func (s *BitnotExprContext) UnaryExpr() IUnaryExprContext {
+func (s *AttrPostfixContext) PostfixExpr() IPostfixExprContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IUnaryExprContext); ok {
+		if _, ok := ctx.(IPostfixExprContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6150,50 +7494,58 @@ func (s *BitnotExprContext) UnaryExpr() IUnaryExprContext {
 		return nil
 	}
 
-	return t.(IUnaryExprContext)
+	return t.(IPostfixExprContext)
 }
 
-func (s *BitnotExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AttrPostfixContext) DOT() antlr.TerminalNode {
+	return s.GetToken(InscriptParserDOT, 0)
+}
+
+func (s *AttrPostfixContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(InscriptParserIDENTIFIER, 0)
+}
+
+func (s *AttrPostfixContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterBitnotExpr(s)
+		listenerT.EnterAttrPostfix(s)
 	}
 }
 
-func (s *BitnotExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AttrPostfixContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitBitnotExpr(s)
+		listenerT.ExitAttrPostfix(s)
 	}
 }
 
-func (s *BitnotExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AttrPostfixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitBitnotExpr(s)
+		return t.VisitAttrPostfix(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type PostfixExpressionContext struct {
-	UnaryExprContext
+type CallPostfixContext struct {
+	PostfixExprContext
 }
 
-func NewPostfixExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PostfixExpressionContext {
-	var p = new(PostfixExpressionContext)
+func NewCallPostfixContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CallPostfixContext {
+	var p = new(CallPostfixContext)
 
-	InitEmptyUnaryExprContext(&p.UnaryExprContext)
+	InitEmptyPostfixExprContext(&p.PostfixExprContext)
 	p.parser = parser
-	p.CopyAll(ctx.(*UnaryExprContext))
+	p.CopyAll(ctx.(*PostfixExprContext))
 
 	return p
 }
 
-func (s *PostfixExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *CallPostfixContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PostfixExpressionContext) PostfixExpr() IPostfixExprContext {
+func (s *CallPostfixContext) PostfixExpr() IPostfixExprContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IPostfixExprContext); ok {
@@ -6209,97 +7561,212 @@ func (s *PostfixExpressionContext) PostfixExpr() IPostfixExprContext {
 	return t.(IPostfixExprContext)
 }
 
-func (s *PostfixExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CallPostfixContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(InscriptParserLPAREN, 0)
+}
+
+func (s *CallPostfixContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(InscriptParserRPAREN, 0)
+}
+
+func (s *CallPostfixContext) ArgList() IArgListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IArgListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArgListContext)
+}
+
+func (s *CallPostfixContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterPostfixExpression(s)
+		listenerT.EnterCallPostfix(s)
 	}
 }
 
-func (s *PostfixExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CallPostfixContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitPostfixExpression(s)
+		listenerT.ExitCallPostfix(s)
 	}
 }
 
-func (s *PostfixExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CallPostfixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitPostfixExpression(s)
+		return t.VisitCallPostfix(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *InscriptParser) UnaryExpr() (localctx IUnaryExprContext) {
-	localctx = NewUnaryExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, InscriptParserRULE_unaryExpr)
-	p.SetState(261)
+func (p *InscriptParser) PostfixExpr() (localctx IPostfixExprContext) {
+	return p.postfixExpr(0)
+}
+
+func (p *InscriptParser) postfixExpr(_p int) (localctx IPostfixExprContext) {
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+
+	_parentState := p.GetState()
+	localctx = NewPostfixExprContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IPostfixExprContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 40
+	p.EnterRecursionRule(localctx, 40, InscriptParserRULE_postfixExpr, _p)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	localctx = NewPrimaryPostfixContext(p, localctx)
+	p.SetParserRuleContext(localctx)
+	_prevctx = localctx
+
+	{
+		p.SetState(264)
+		p.Primary()
+	}
+
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(282)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-
-	switch p.GetTokenStream().LA(1) {
-	case InscriptParserNOT:
-		localctx = NewNotExprContext(p, localctx)
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(254)
-			p.Match(InscriptParserNOT)
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 20, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
+			}
+			_prevctx = localctx
+			p.SetState(280)
+			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
-				// Recognition error - abort rule
 				goto errorExit
 			}
-		}
-		{
-			p.SetState(255)
-			p.UnaryExpr()
-		}
 
-	case InscriptParserBITNOT:
-		localctx = NewBitnotExprContext(p, localctx)
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(256)
-			p.Match(InscriptParserBITNOT)
-			if p.HasError() {
-				// Recognition error - abort rule
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 19, p.GetParserRuleContext()) {
+			case 1:
+				localctx = NewCallPostfixContext(p, NewPostfixExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_postfixExpr)
+				p.SetState(266)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(267)
+					p.Match(InscriptParserLPAREN)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				p.SetState(269)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+
+				if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&126470225742950402) != 0 {
+					{
+						p.SetState(268)
+						p.ArgList()
+					}
+
+				}
+				{
+					p.SetState(271)
+					p.Match(InscriptParserRPAREN)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+			case 2:
+				localctx = NewIndexPostfixContext(p, NewPostfixExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_postfixExpr)
+				p.SetState(272)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(273)
+					p.Match(InscriptParserLBRACK)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(274)
+					p.expression(0)
+				}
+				{
+					p.SetState(275)
+					p.Match(InscriptParserRBRACK)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+			case 3:
+				localctx = NewAttrPostfixContext(p, NewPostfixExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_postfixExpr)
+				p.SetState(277)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(278)
+					p.Match(InscriptParserDOT)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(279)
+					p.Match(InscriptParserIDENTIFIER)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+			case antlr.ATNInvalidAltNumber:
 				goto errorExit
 			}
-		}
-		{
-			p.SetState(257)
-			p.UnaryExpr()
-		}
 
-	case InscriptParserSUB:
-		localctx = NewNegExprContext(p, localctx)
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(258)
-			p.Match(InscriptParserSUB)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
 		}
-		{
-			p.SetState(259)
-			p.UnaryExpr()
+		p.SetState(284)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
-
-	case InscriptParserTRUE, InscriptParserFALSE, InscriptParserNIL, InscriptParserLPAREN, InscriptParserLBRACK, InscriptParserLBRACE, InscriptParserIDENTIFIER, InscriptParserNUMBER, InscriptParserSTRING:
-		localctx = NewPostfixExpressionContext(p, localctx)
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(260)
-			p.postfixExpr(0)
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 20, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
 		}
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
 	}
 
 errorExit:
@@ -6310,89 +7777,91 @@ errorExit:
 		p.GetErrorHandler().Recover(p, v)
 		p.SetError(nil)
 	}
-	p.ExitRule()
+	p.UnrollRecursionContexts(_parentctx)
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IPostfixExprContext is an interface to support dynamic dispatch.
-type IPostfixExprContext interface {
+// IArgListContext is an interface to support dynamic dispatch.
+type IArgListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-	// IsPostfixExprContext differentiates from other interfaces.
-	IsPostfixExprContext()
+
+	// Getter signatures
+	AllArgument() []IArgumentContext
+	Argument(i int) IArgumentContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsArgListContext differentiates from other interfaces.
+	IsArgListContext()
 }
 
-type PostfixExprContext struct {
+type ArgListContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPostfixExprContext() *PostfixExprContext {
-	var p = new(PostfixExprContext)
+func NewEmptyArgListContext() *ArgListContext {
+	var p = new(ArgListContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = InscriptParserRULE_postfixExpr
+	p.RuleIndex = InscriptParserRULE_argList
 	return p
 }
 
-func InitEmptyPostfixExprContext(p *PostfixExprContext) {
+func InitEmptyArgListContext(p *ArgListContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = InscriptParserRULE_postfixExpr
+	p.RuleIndex = InscriptParserRULE_argList
 }
 
-func (*PostfixExprContext) IsPostfixExprContext() {}
+func (*ArgListContext) IsArgListContext() {}
 
-func NewPostfixExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PostfixExprContext {
-	var p = new(PostfixExprContext)
+func NewArgListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgListContext {
+	var p = new(ArgListContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = InscriptParserRULE_postfixExpr
+	p.RuleIndex = InscriptParserRULE_argList
 
 	return p
 }
 
-func (s *PostfixExprContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *PostfixExprContext) CopyAll(ctx *PostfixExprContext) {
-	s.CopyFrom(&ctx.BaseParserRuleContext)
-}
-
-func (s *PostfixExprContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *PostfixExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-type PrimaryPostfixContext struct {
-	PostfixExprContext
-}
-
-func NewPrimaryPostfixContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PrimaryPostfixContext {
-	var p = new(PrimaryPostfixContext)
+func (s *ArgListContext) GetParser() antlr.Parser { return s.parser }
 
-	InitEmptyPostfixExprContext(&p.PostfixExprContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*PostfixExprContext))
+func (s *ArgListContext) AllArgument() []IArgumentContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IArgumentContext); ok {
+			len++
+		}
+	}
 
-	return p
-}
+	tst := make([]IArgumentContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IArgumentContext); ok {
+			tst[i] = t.(IArgumentContext)
+			i++
+		}
+	}
 
-func (s *PrimaryPostfixContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return tst
 }
 
-func (s *PrimaryPostfixContext) Primary() IPrimaryContext {
+func (s *ArgListContext) Argument(i int) IArgumentContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPrimaryContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IArgumentContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -6400,70 +7869,156 @@ func (s *PrimaryPostfixContext) Primary() IPrimaryContext {
 		return nil
 	}
 
-	return t.(IPrimaryContext)
+	return t.(IArgumentContext)
 }
 
-func (s *PrimaryPostfixContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ArgListContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(InscriptParserCOMMA)
+}
+
+func (s *ArgListContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(InscriptParserCOMMA, i)
+}
+
+func (s *ArgListContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ArgListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ArgListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterPrimaryPostfix(s)
+		listenerT.EnterArgList(s)
 	}
 }
 
-func (s *PrimaryPostfixContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ArgListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitPrimaryPostfix(s)
+		listenerT.ExitArgList(s)
 	}
 }
 
-func (s *PrimaryPostfixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ArgListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitPrimaryPostfix(s)
+		return t.VisitArgList(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type IndexPostfixContext struct {
-	PostfixExprContext
+func (p *InscriptParser) ArgList() (localctx IArgListContext) {
+	localctx = NewArgListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, InscriptParserRULE_argList)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(285)
+		p.Argument()
+	}
+	p.SetState(290)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == InscriptParserCOMMA {
+		{
+			p.SetState(286)
+			p.Match(InscriptParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(287)
+			p.Argument()
+		}
+
+		p.SetState(292)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-func NewIndexPostfixContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IndexPostfixContext {
-	var p = new(IndexPostfixContext)
+// IArgumentContext is an interface to support dynamic dispatch.
+type IArgumentContext interface {
+	antlr.ParserRuleContext
 
-	InitEmptyPostfixExprContext(&p.PostfixExprContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*PostfixExprContext))
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IDENTIFIER() antlr.TerminalNode
+	ASSIGN() antlr.TerminalNode
+	Expression() IExpressionContext
+
+	// IsArgumentContext differentiates from other interfaces.
+	IsArgumentContext()
+}
+
+type ArgumentContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
+func NewEmptyArgumentContext() *ArgumentContext {
+	var p = new(ArgumentContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = InscriptParserRULE_argument
 	return p
 }
 
-func (s *IndexPostfixContext) GetRuleContext() antlr.RuleContext {
-	return s
+func InitEmptyArgumentContext(p *ArgumentContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = InscriptParserRULE_argument
 }
 
-func (s *IndexPostfixContext) PostfixExpr() IPostfixExprContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPostfixExprContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (*ArgumentContext) IsArgumentContext() {}
+
+func NewArgumentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgumentContext {
+	var p = new(ArgumentContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
-	if t == nil {
-		return nil
-	}
+	p.parser = parser
+	p.RuleIndex = InscriptParserRULE_argument
 
-	return t.(IPostfixExprContext)
+	return p
 }
 
-func (s *IndexPostfixContext) LBRACK() antlr.TerminalNode {
-	return s.GetToken(InscriptParserLBRACK, 0)
+func (s *ArgumentContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ArgumentContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(InscriptParserIDENTIFIER, 0)
 }
 
-func (s *IndexPostfixContext) Expression() IExpressionContext {
+func (s *ArgumentContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(InscriptParserASSIGN, 0)
+}
+
+func (s *ArgumentContext) Expression() IExpressionContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IExpressionContext); ok {
@@ -6479,56 +8034,173 @@ func (s *IndexPostfixContext) Expression() IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *IndexPostfixContext) RBRACK() antlr.TerminalNode {
-	return s.GetToken(InscriptParserRBRACK, 0)
+func (s *ArgumentContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *IndexPostfixContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ArgumentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ArgumentContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterIndexPostfix(s)
+		listenerT.EnterArgument(s)
 	}
 }
 
-func (s *IndexPostfixContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ArgumentContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitIndexPostfix(s)
+		listenerT.ExitArgument(s)
 	}
 }
 
-func (s *IndexPostfixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ArgumentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitIndexPostfix(s)
+		return t.VisitArgument(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type AttrPostfixContext struct {
-	PostfixExprContext
+func (p *InscriptParser) Argument() (localctx IArgumentContext) {
+	localctx = NewArgumentContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 399, InscriptParserRULE_argument)
+	p.SetState(406)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 35, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(402)
+			p.Match(InscriptParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(403)
+			p.Match(InscriptParserASSIGN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(404)
+			p.expression(0)
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(405)
+			p.expression(0)
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-func NewAttrPostfixContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AttrPostfixContext {
-	var p = new(AttrPostfixContext)
+// ITargetListContext is an interface to support dynamic dispatch.
+type ITargetListContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllTargetItem() []ITargetItemContext
+	TargetItem(i int) ITargetItemContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsTargetListContext differentiates from other interfaces.
+	IsTargetListContext()
+}
+
+type TargetListContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTargetListContext() *TargetListContext {
+	var p = new(TargetListContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = InscriptParserRULE_targetList
+	return p
+}
+
+func InitEmptyTargetListContext(p *TargetListContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = InscriptParserRULE_targetList
+}
+
+func (*TargetListContext) IsTargetListContext() {}
+
+func NewTargetListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TargetListContext {
+	var p = new(TargetListContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
-	InitEmptyPostfixExprContext(&p.PostfixExprContext)
 	p.parser = parser
-	p.CopyAll(ctx.(*PostfixExprContext))
+	p.RuleIndex = InscriptParserRULE_targetList
 
 	return p
 }
 
-func (s *AttrPostfixContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *TargetListContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TargetListContext) AllTargetItem() []ITargetItemContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITargetItemContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITargetItemContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITargetItemContext); ok {
+			tst[i] = t.(ITargetItemContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *AttrPostfixContext) PostfixExpr() IPostfixExprContext {
+func (s *TargetListContext) TargetItem(i int) ITargetItemContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPostfixExprContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(ITargetItemContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -6536,61 +8208,154 @@ func (s *AttrPostfixContext) PostfixExpr() IPostfixExprContext {
 		return nil
 	}
 
-	return t.(IPostfixExprContext)
+	return t.(ITargetItemContext)
 }
 
-func (s *AttrPostfixContext) DOT() antlr.TerminalNode {
-	return s.GetToken(InscriptParserDOT, 0)
+func (s *TargetListContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(InscriptParserCOMMA)
 }
 
-func (s *AttrPostfixContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(InscriptParserIDENTIFIER, 0)
+func (s *TargetListContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(InscriptParserCOMMA, i)
 }
 
-func (s *AttrPostfixContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TargetListContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TargetListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TargetListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterAttrPostfix(s)
+		listenerT.EnterTargetList(s)
 	}
 }
 
-func (s *AttrPostfixContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TargetListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitAttrPostfix(s)
+		listenerT.ExitTargetList(s)
 	}
 }
 
-func (s *AttrPostfixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TargetListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitAttrPostfix(s)
+		return t.VisitTargetList(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type CallPostfixContext struct {
-	PostfixExprContext
+func (p *InscriptParser) TargetList() (localctx ITargetListContext) {
+	localctx = NewTargetListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 410, InscriptParserRULE_targetList)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(416)
+		p.TargetItem()
+	}
+	p.SetState(421)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == InscriptParserCOMMA {
+		{
+			p.SetState(418)
+			p.Match(InscriptParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(419)
+			p.TargetItem()
+		}
+
+		p.SetState(423)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-func NewCallPostfixContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CallPostfixContext {
-	var p = new(CallPostfixContext)
+// ITargetItemContext is an interface to support dynamic dispatch.
+type ITargetItemContext interface {
+	antlr.ParserRuleContext
 
-	InitEmptyPostfixExprContext(&p.PostfixExprContext)
-	p.parser = parser
-	p.CopyAll(ctx.(*PostfixExprContext))
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Target() ITargetContext
+	LPAREN() antlr.TerminalNode
+	TargetList() ITargetListContext
+	RPAREN() antlr.TerminalNode
+	ELLIPSIS() antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
+
+	// IsTargetItemContext differentiates from other interfaces.
+	IsTargetItemContext()
+}
 
+type TargetItemContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTargetItemContext() *TargetItemContext {
+	var p = new(TargetItemContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = InscriptParserRULE_targetItem
 	return p
 }
 
-func (s *CallPostfixContext) GetRuleContext() antlr.RuleContext {
-	return s
+func InitEmptyTargetItemContext(p *TargetItemContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = InscriptParserRULE_targetItem
 }
 
-func (s *CallPostfixContext) PostfixExpr() IPostfixExprContext {
+func (*TargetItemContext) IsTargetItemContext() {}
+
+func NewTargetItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TargetItemContext {
+	var p = new(TargetItemContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = InscriptParserRULE_targetItem
+
+	return p
+}
+
+func (s *TargetItemContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TargetItemContext) Target() ITargetContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPostfixExprContext); ok {
+		if _, ok := ctx.(ITargetContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6600,21 +8365,17 @@ func (s *CallPostfixContext) PostfixExpr() IPostfixExprContext {
 		return nil
 	}
 
-	return t.(IPostfixExprContext)
+	return t.(ITargetContext)
 }
 
-func (s *CallPostfixContext) LPAREN() antlr.TerminalNode {
+func (s *TargetItemContext) LPAREN() antlr.TerminalNode {
 	return s.GetToken(InscriptParserLPAREN, 0)
 }
 
-func (s *CallPostfixContext) RPAREN() antlr.TerminalNode {
-	return s.GetToken(InscriptParserRPAREN, 0)
-}
-
-func (s *CallPostfixContext) ArgList() IArgListContext {
+func (s *TargetItemContext) TargetList() ITargetListContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IArgListContext); ok {
+		if _, ok := ctx.(ITargetListContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6624,191 +8385,112 @@ func (s *CallPostfixContext) ArgList() IArgListContext {
 		return nil
 	}
 
-	return t.(IArgListContext)
+	return t.(ITargetListContext)
 }
 
-func (s *CallPostfixContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TargetItemContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(InscriptParserRPAREN, 0)
+}
+
+func (s *TargetItemContext) ELLIPSIS() antlr.TerminalNode {
+	return s.GetToken(InscriptParserELLIPSIS, 0)
+}
+
+func (s *TargetItemContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(InscriptParserIDENTIFIER, 0)
+}
+
+func (s *TargetItemContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TargetItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TargetItemContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterCallPostfix(s)
+		listenerT.EnterTargetItem(s)
 	}
 }
 
-func (s *CallPostfixContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TargetItemContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitCallPostfix(s)
+		listenerT.ExitTargetItem(s)
 	}
 }
 
-func (s *CallPostfixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TargetItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitCallPostfix(s)
+		return t.VisitTargetItem(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *InscriptParser) PostfixExpr() (localctx IPostfixExprContext) {
-	return p.postfixExpr(0)
-}
-
-func (p *InscriptParser) postfixExpr(_p int) (localctx IPostfixExprContext) {
-	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
-
-	_parentState := p.GetState()
-	localctx = NewPostfixExprContext(p, p.GetParserRuleContext(), _parentState)
-	var _prevctx IPostfixExprContext = localctx
-	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 40
-	p.EnterRecursionRule(localctx, 40, InscriptParserRULE_postfixExpr, _p)
-	var _la int
-
-	var _alt int
-
-	p.EnterOuterAlt(localctx, 1)
-	localctx = NewPrimaryPostfixContext(p, localctx)
-	p.SetParserRuleContext(localctx)
-	_prevctx = localctx
-
-	{
-		p.SetState(264)
-		p.Primary()
-	}
-
-	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(282)
+func (p *InscriptParser) TargetItem() (localctx ITargetItemContext) {
+	localctx = NewTargetItemContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 412, InscriptParserRULE_targetItem)
+	p.SetState(431)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 20, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			if p.GetParseListeners() != nil {
-				p.TriggerExitRuleEvent()
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 38, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(425)
+			p.Target()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(426)
+			p.Match(InscriptParserLPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
 			}
-			_prevctx = localctx
-			p.SetState(280)
-			p.GetErrorHandler().Sync(p)
+		}
+		{
+			p.SetState(427)
+			p.TargetList()
+		}
+		{
+			p.SetState(428)
+			p.Match(InscriptParserRPAREN)
 			if p.HasError() {
+				// Recognition error - abort rule
 				goto errorExit
 			}
+		}
 
-			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 19, p.GetParserRuleContext()) {
-			case 1:
-				localctx = NewCallPostfixContext(p, NewPostfixExprContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_postfixExpr)
-				p.SetState(266)
-
-				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(267)
-					p.Match(InscriptParserLPAREN)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				p.SetState(269)
-				p.GetErrorHandler().Sync(p)
-				if p.HasError() {
-					goto errorExit
-				}
-				_la = p.GetTokenStream().LA(1)
-
-				if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&126470225742950400) != 0 {
-					{
-						p.SetState(268)
-						p.ArgList()
-					}
-
-				}
-				{
-					p.SetState(271)
-					p.Match(InscriptParserRPAREN)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-
-			case 2:
-				localctx = NewIndexPostfixContext(p, NewPostfixExprContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_postfixExpr)
-				p.SetState(272)
-
-				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(273)
-					p.Match(InscriptParserLBRACK)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(274)
-					p.expression(0)
-				}
-				{
-					p.SetState(275)
-					p.Match(InscriptParserRBRACK)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-
-			case 3:
-				localctx = NewAttrPostfixContext(p, NewPostfixExprContext(p, _parentctx, _parentState))
-				p.PushNewRecursionContext(localctx, _startState, InscriptParserRULE_postfixExpr)
-				p.SetState(277)
-
-				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(278)
-					p.Match(InscriptParserDOT)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(279)
-					p.Match(InscriptParserIDENTIFIER)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-
-			case antlr.ATNInvalidAltNumber:
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(429)
+			p.Match(InscriptParserELLIPSIS)
+			if p.HasError() {
+				// Recognition error - abort rule
 				goto errorExit
 			}
-
 		}
-		p.SetState(284)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 20, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
+		{
+			p.SetState(430)
+			p.Match(InscriptParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -6819,13 +8501,13 @@ errorExit:
 		p.GetErrorHandler().Recover(p, v)
 		p.SetError(nil)
 	}
-	p.UnrollRecursionContexts(_parentctx)
+	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IArgListContext is an interface to support dynamic dispatch.
-type IArgListContext interface {
+// IExpressionListContext is an interface to support dynamic dispatch.
+type IExpressionListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -6837,43 +8519,43 @@ type IArgListContext interface {
 	AllCOMMA() []antlr.TerminalNode
 	COMMA(i int) antlr.TerminalNode
 
-	// IsArgListContext differentiates from other interfaces.
-	IsArgListContext()
+	// IsExpressionListContext differentiates from other interfaces.
+	IsExpressionListContext()
 }
 
-type ArgListContext struct {
+type ExpressionListContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyArgListContext() *ArgListContext {
-	var p = new(ArgListContext)
+func NewEmptyExpressionListContext() *ExpressionListContext {
+	var p = new(ExpressionListContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = InscriptParserRULE_argList
+	p.RuleIndex = InscriptParserRULE_expressionList
 	return p
 }
 
-func InitEmptyArgListContext(p *ArgListContext) {
+func InitEmptyExpressionListContext(p *ExpressionListContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = InscriptParserRULE_argList
+	p.RuleIndex = InscriptParserRULE_expressionList
 }
 
-func (*ArgListContext) IsArgListContext() {}
+func (*ExpressionListContext) IsExpressionListContext() {}
 
-func NewArgListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgListContext {
-	var p = new(ArgListContext)
+func NewExpressionListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionListContext {
+	var p = new(ExpressionListContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = InscriptParserRULE_argList
+	p.RuleIndex = InscriptParserRULE_expressionList
 
 	return p
 }
 
-func (s *ArgListContext) GetParser() antlr.Parser { return s.parser }
+func (s *ExpressionListContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ArgListContext) AllExpression() []IExpressionContext {
+func (s *ExpressionListContext) AllExpression() []IExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -6894,7 +8576,7 @@ func (s *ArgListContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *ArgListContext) Expression(i int) IExpressionContext {
+func (s *ExpressionListContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -6914,55 +8596,55 @@ func (s *ArgListContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *ArgListContext) AllCOMMA() []antlr.TerminalNode {
+func (s *ExpressionListContext) AllCOMMA() []antlr.TerminalNode {
 	return s.GetTokens(InscriptParserCOMMA)
 }
 
-func (s *ArgListContext) COMMA(i int) antlr.TerminalNode {
+func (s *ExpressionListContext) COMMA(i int) antlr.TerminalNode {
 	return s.GetToken(InscriptParserCOMMA, i)
 }
 
-func (s *ArgListContext) GetRuleContext() antlr.RuleContext {
+func (s *ExpressionListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ArgListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ExpressionListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ArgListContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ExpressionListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.EnterArgList(s)
+		listenerT.EnterExpressionList(s)
 	}
 }
 
-func (s *ArgListContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ExpressionListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(InscriptListener); ok {
-		listenerT.ExitArgList(s)
+		listenerT.ExitExpressionList(s)
 	}
 }
 
-func (s *ArgListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ExpressionListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case InscriptVisitor:
-		return t.VisitArgList(s)
+		return t.VisitExpressionList(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *InscriptParser) ArgList() (localctx IArgListContext) {
-	localctx = NewArgListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, InscriptParserRULE_argList)
+func (p *InscriptParser) ExpressionList() (localctx IExpressionListContext) {
+	localctx = NewExpressionListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 414, InscriptParserRULE_expressionList)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(285)
+		p.SetState(432)
 		p.expression(0)
 	}
-	p.SetState(290)
+	p.SetState(437)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -6971,7 +8653,7 @@ func (p *InscriptParser) ArgList() (localctx IArgListContext) {
 
 	for _la == InscriptParserCOMMA {
 		{
-			p.SetState(286)
+			p.SetState(434)
 			p.Match(InscriptParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6979,11 +8661,11 @@ func (p *InscriptParser) ArgList() (localctx IArgListContext) {
 			}
 		}
 		{
-			p.SetState(287)
+			p.SetState(435)
 			p.expression(0)
 		}
 
-		p.SetState(292)
+		p.SetState(439)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -7022,6 +8704,8 @@ type IPrimaryContext interface {
 	COMMA(i int) antlr.TerminalNode
 	ListLiteral() IListLiteralContext
 	TableLiteral() ITableLiteralContext
+	FunctionLiteral() IFunctionLiteralContext
+	ArrowFunction() IArrowFunctionContext
 
 	// IsPrimaryContext differentiates from other interfaces.
 	IsPrimaryContext()
@@ -7168,6 +8852,38 @@ func (s *PrimaryContext) TableLiteral() ITableLiteralContext {
 	return t.(ITableLiteralContext)
 }
 
+func (s *PrimaryContext) FunctionLiteral() IFunctionLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFunctionLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionLiteralContext)
+}
+
+func (s *PrimaryContext) ArrowFunction() IArrowFunctionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IArrowFunctionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArrowFunctionContext)
+}
+
 func (s *PrimaryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -7316,6 +9032,20 @@ func (p *InscriptParser) Primary() (localctx IPrimaryContext) {
 			p.TableLiteral()
 		}
 
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(397)
+			p.FunctionLiteral()
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(398)
+			p.ArrowFunction()
+		}
+
 	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
@@ -7623,7 +9353,7 @@ func (p *InscriptParser) ListLiteral() (localctx IListLiteralContext) {
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&126470225742950400) != 0 {
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&126470225742950402) != 0 {
 		{
 			p.SetState(316)
 			p.expression(0)
@@ -7839,7 +9569,7 @@ func (p *InscriptParser) TableLiteral() (localctx ITableLiteralContext) {
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&126470225742950400) != 0 {
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&126470225742950402) != 0 {
 		{
 			p.SetState(329)
 			p.TableKeyValue()
@@ -62,6 +62,18 @@ type InscriptVisitor interface {
 	// Visit a parse tree produced by InscriptParser#printStmt.
 	VisitPrintStmt(ctx *PrintStmtContext) interface{}
 
+	// Visit a parse tree produced by InscriptParser#tryStmt.
+	VisitTryStmt(ctx *TryStmtContext) interface{}
+
+	// Visit a parse tree produced by InscriptParser#throwStmt.
+	VisitThrowStmt(ctx *ThrowStmtContext) interface{}
+
+	// Visit a parse tree produced by InscriptParser#functionLiteral.
+	VisitFunctionLiteral(ctx *FunctionLiteralContext) interface{}
+
+	// Visit a parse tree produced by InscriptParser#arrowFunction.
+	VisitArrowFunction(ctx *ArrowFunctionContext) interface{}
+
 	// Visit a parse tree produced by InscriptParser#geExpr.
 	VisitGeExpr(ctx *GeExprContext) interface{}
 
@@ -152,6 +164,18 @@ type InscriptVisitor interface {
 	// Visit a parse tree produced by InscriptParser#argList.
 	VisitArgList(ctx *ArgListContext) interface{}
 
+	// Visit a parse tree produced by InscriptParser#argument.
+	VisitArgument(ctx *ArgumentContext) interface{}
+
+	// Visit a parse tree produced by InscriptParser#targetList.
+	VisitTargetList(ctx *TargetListContext) interface{}
+
+	// Visit a parse tree produced by InscriptParser#targetItem.
+	VisitTargetItem(ctx *TargetItemContext) interface{}
+
+	// Visit a parse tree produced by InscriptParser#expressionList.
+	VisitExpressionList(ctx *ExpressionListContext) interface{}
+
 	// Visit a parse tree produced by InscriptParser#primary.
 	VisitPrimary(ctx *PrimaryContext) interface{}
 
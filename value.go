@@ -0,0 +1,189 @@
+package inscript
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/SethGK/Inscript/internal/types"
+)
+
+// Object wraps a script value with no natural Go representation, such as a
+// function or an iterator. It can be passed back into a script unchanged.
+type Object struct {
+	value types.Value
+}
+
+// String returns the value as the script would print it.
+func (o Object) String() string { return o.value.Inspect() }
+
+// toValue converts a Go value to a script value:
+//
+//	nil                  -> nil
+//	bool                 -> bool
+//	all integer kinds    -> int (unsigned values above math.MaxInt64 are an error)
+//	float32, float64     -> float
+//	string               -> string
+//	[]any                -> list
+//	map[string]any       -> table (keys in sorted order)
+//	map[any]any          -> table (keys converted, then sorted)
+//	Object               -> the wrapped value
+func toValue(v any) (types.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return &types.Nil{}, nil
+	case bool:
+		return types.NewBoolean(v), nil
+	case int:
+		return types.NewInteger(int64(v)), nil
+	case int8:
+		return types.NewInteger(int64(v)), nil
+	case int16:
+		return types.NewInteger(int64(v)), nil
+	case int32:
+		return types.NewInteger(int64(v)), nil
+	case int64:
+		return types.NewInteger(v), nil
+	case uint:
+		return fromUint(uint64(v))
+	case uint8:
+		return types.NewInteger(int64(v)), nil
+	case uint16:
+		return types.NewInteger(int64(v)), nil
+	case uint32:
+		return types.NewInteger(int64(v)), nil
+	case uint64:
+		return fromUint(v)
+	case float32:
+		return types.NewFloat(float64(v)), nil
+	case float64:
+		return types.NewFloat(v), nil
+	case string:
+		return types.NewString(v), nil
+	case []any:
+		elements := make([]types.Value, len(v))
+		for i, el := range v {
+			converted, err := toValue(el)
+			if err != nil {
+				return nil, err
+			}
+			elements[i] = converted
+		}
+		return types.NewList(elements...), nil
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		pairs := make([]types.TablePair, len(keys))
+		for i, k := range keys {
+			converted, err := toValue(v[k])
+			if err != nil {
+				return nil, err
+			}
+			pairs[i] = types.TablePair{Key: types.NewString(k), Value: converted}
+		}
+		return types.NewTable(pairs), nil
+	case map[any]any:
+		pairs := make([]types.TablePair, 0, len(v))
+		for k, el := range v {
+			key, err := toValue(k)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := types.HashKeyOf(key); err != nil {
+				return nil, fmt.Errorf("table key %v: %w", k, err)
+			}
+			converted, err := toValue(el)
+			if err != nil {
+				return nil, err
+			}
+			pairs = append(pairs, types.TablePair{Key: key, Value: converted})
+		}
+		sort.Slice(pairs, func(i, j int) bool { return keyLess(pairs[i].Key, pairs[j].Key) })
+		return types.NewTable(pairs), nil
+	case Object:
+		return v.value, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %T to an Inscript value", v)
+	}
+}
+
+// fromUint converts an unsigned integer, which must fit in an int64.
+func fromUint(v uint64) (types.Value, error) {
+	if v > math.MaxInt64 {
+		return nil, fmt.Errorf("cannot convert %d to an Inscript integer: out of range", v)
+	}
+	return types.NewInteger(int64(v)), nil
+}
+
+// fromValue converts a script value to Go. It is the inverse of toValue:
+// ints become int64, floats float64, lists and tuples []any and tables
+// map[string]any, or map[any]any if any key is not a string. Values with no
+// Go counterpart are returned as Object.
+func fromValue(v types.Value) any {
+	switch v := v.(type) {
+	case nil, *types.Nil:
+		return nil
+	case *types.Boolean:
+		return v.Value
+	case *types.Integer:
+		return v.Value
+	case *types.Float:
+		return v.Value
+	case *types.String:
+		return v.Value
+	case *types.List:
+		elements := make([]any, len(v.Elements))
+		for i, el := range v.Elements {
+			elements[i] = fromValue(el)
+		}
+		return elements
+	case *types.Tuple:
+		elements := make([]any, len(v.Elements))
+		for i, el := range v.Elements {
+			elements[i] = fromValue(el)
+		}
+		return elements
+	case *types.Table:
+		fields := make(map[string]any, len(v.Pairs))
+		for _, pair := range v.Pairs {
+			key, ok := pair.Key.(*types.String)
+			if !ok {
+				return fromMixedTable(v)
+			}
+			fields[key.Value] = fromValue(pair.Value)
+		}
+		return fields
+	default:
+		return Object{value: v}
+	}
+}
+
+// fromMixedTable converts a table with keys other than strings. Tuple keys
+// stay Objects, since the []any they would become cannot be a map key.
+func fromMixedTable(t *types.Table) map[any]any {
+	fields := make(map[any]any, len(t.Pairs))
+	for _, pair := range t.Pairs {
+		var key any = Object{value: pair.Key}
+		if _, isTuple := pair.Key.(*types.Tuple); !isTuple {
+			key = fromValue(pair.Key)
+		}
+		fields[key] = fromValue(pair.Value)
+	}
+	return fields
+}
+
+// keyLess orders table keys converted from a Go map: by type, then by value
+// where the type is ordered.
+func keyLess(a, b types.Value) bool {
+	if a.Type() != b.Type() {
+		return a.Type() < b.Type()
+	}
+	cmp, err := a.Compare(b)
+	if err != nil {
+		return a.Inspect() < b.Inspect()
+	}
+	return cmp < 0
+}